@@ -1,11 +1,17 @@
 package ddlparse
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/kodaimura/ddlparse/internal/types"
 	"github.com/kodaimura/ddlparse/internal/common"
 	"github.com/kodaimura/ddlparse/internal/lexer"
 	"github.com/kodaimura/ddlparse/internal/validator"
 	"github.com/kodaimura/ddlparse/internal/converter"
+	"github.com/kodaimura/ddlparse/internal/catalog"
+	"github.com/kodaimura/ddlparse/internal/sqlitefile"
+	"github.com/kodaimura/ddlparse/internal/pgarchive"
 )
 
 
@@ -15,16 +21,39 @@ type (
 	DataType = types.DataType
 	Constraint = types.Constraint
 	Reference = types.Reference
+	DefaultValue = types.DefaultValue
+	Generated = types.Generated
+	Expression = types.Expression
+	Expr = types.Expr
+	CaseWhen = types.CaseWhen
+	Identity = types.Identity
+	SequenceOptions = types.SequenceOptions
+	Sequence = types.Sequence
+	SequenceOwner = types.SequenceOwner
+	Result = types.Result
+	Schema = types.Schema
 	TableConstraint = types.TableConstraint
 	PrimaryKey = types.PrimaryKey
 	Unique = types.Unique
+	IndexParameters = types.IndexParameters
 	Check = types.Check
 	ForeignKey = types.ForeignKey
+	Exclude = types.Exclude
+	ExcludeElement = types.ExcludeElement
+	SQLiteMasterRow = types.SQLiteMasterRow
+	PgDumpEntry = types.PgDumpEntry
+)
+
+type (
+	Catalog = catalog.Catalog
+	Namespace = catalog.Namespace
+	Relation = catalog.Relation
 )
 
 type (
 	Rdbms = common.Rdbms
 	ValidateError = common.ValidateError
+	Options = common.Options
 )
 
 const (
@@ -33,24 +62,173 @@ const (
 	SQLite = common.SQLite
 )
 
+const (
+	DefaultNull = types.DefaultNull
+	DefaultNumber = types.DefaultNumber
+	DefaultString = types.DefaultString
+	DefaultBoolean = types.DefaultBoolean
+	DefaultKeyword = types.DefaultKeyword
+	DefaultFunction = types.DefaultFunction
+	DefaultExpression = types.DefaultExpression
+)
+
+const (
+	ExprNumber = types.ExprNumber
+	ExprString = types.ExprString
+	ExprBoolean = types.ExprBoolean
+	ExprNull = types.ExprNull
+	ExprKeyword = types.ExprKeyword
+	ExprStar = types.ExprStar
+	ExprColumn = types.ExprColumn
+	ExprUnary = types.ExprUnary
+	ExprBinary = types.ExprBinary
+	ExprLike = types.ExprLike
+	ExprIn = types.ExprIn
+	ExprBetween = types.ExprBetween
+	ExprFunction = types.ExprFunction
+	ExprCast = types.ExprCast
+	ExprCollate = types.ExprCollate
+	ExprCase = types.ExprCase
+)
+
 func Parse(ddl string, rdbms Rdbms) ([]Table, error) {
-	l := lexer.NewLexer(rdbms)
-	v := validator.NewValidator(rdbms)
-	c := converter.NewConverter(rdbms)
+	result, err := ParseAll(ddl, rdbms, Options{})
+	return result.Tables, err
+}
+
+func ParseAll(ddl string, rdbms Rdbms, options Options) (Result, error) {
+	l := lexer.NewLexerWithOptions(rdbms, options)
+	v := validator.NewValidatorWithOptions(rdbms, options)
+	c := converter.NewConverterWithOptions(rdbms, options)
 
 	tokens, err := l.Lex(ddl)
 	if err != nil {
-		return []Table{}, err
+		return Result{Tables: []Table{}}, err
 	}
 	
 	validatedTokens, err := v.Validate(tokens)
 	if err != nil {
-		return []Table{}, err
+		return Result{Tables: []Table{}}, err
 	}
 	
 	return c.Convert(validatedTokens), nil
 }
 
+/*
+  ParseCatalog parses like ParseAll and groups the result by schema.
+  NewCatalog groups a Result already parsed.
+*/
+func ParseCatalog(ddl string, rdbms Rdbms, options Options) (*Catalog, error) {
+	result, err := ParseAll(ddl, rdbms, options)
+	return NewCatalog(result, rdbms), err
+}
+
+func NewCatalog(result Result, rdbms Rdbms) *Catalog {
+	return catalog.New(rdbms, result)
+}
+
+/*
+  ParseSQLiteMaster parses the CREATE TABLE statements kept in sqlite_master
+  (SELECT type, name, tbl_name, sql FROM sqlite_master).
+  The rows other than tables are skipped. Virtual tables are returned with Table.Virtual.
+  ParseSQLiteSchema parses the .schema output of the sqlite3 shell.
+  The internal tables (sqlite_sequence, sqlite_stat1, ...) and the shadow tables
+  of virtual tables (docs_data, ...) are flagged by Table.Internal.
+  Each row of sqlite_master is checked on its own: the rows that cannot be parsed
+  are skipped, and the error of the first of them is returned with the other tables.
+*/
+func ParseSQLiteMaster(rows []SQLiteMasterRow, options Options) (Result, error) {
+	options.SQLiteSchema = true
+	ls := []string{}
+	var rowErr error
+	for _, row := range rows {
+		sql := strings.TrimSuffix(strings.TrimSpace(row.SQL), ";")
+		if strings.ToLower(row.Type) != "table" || sql == "" {
+			continue
+		}
+		if err := validate(sql, SQLite, options); err != nil {
+			if rowErr == nil {
+				rowErr = fmt.Errorf("%s: %w", row.Name, err)
+			}
+			continue
+		}
+		ls = append(ls, sql)
+	}
+	result, err := ParseSQLiteSchema(strings.Join(ls, "\n;\n"), options)
+	if err != nil {
+		return result, err
+	}
+	return result, rowErr
+}
+
+func validate(ddl string, rdbms Rdbms, options Options) error {
+	tokens, err := lexer.NewLexerWithOptions(rdbms, options).Lex(ddl)
+	if err != nil {
+		return err
+	}
+	_, err = validator.NewValidatorWithOptions(rdbms, options).Validate(tokens)
+	return err
+}
+
+func ParseSQLiteSchema(schema string, options Options) (Result, error) {
+	options.SQLiteSchema = true
+	return ParseAll(schema, SQLite, options)
+}
+
+/*
+  ParseSQLiteFile reads sqlite_schema from a SQLite database file in pure Go
+  (no cgo, no sqlite3) and parses its tables like ParseSQLiteMaster.
+  The indexes are returned as the rows of type "index"
+  (SQL is empty for the indexes created by UNIQUE and PRIMARY KEY constraints).
+  Changes left in the WAL file (-wal) are not read: checkpoint the database first.
+*/
+func ParseSQLiteFile(path string, options Options) ([]Table, []SQLiteMasterRow, error) {
+	rows, err := sqlitefile.ReadFile(path)
+	if err != nil {
+		return []Table{}, []SQLiteMasterRow{}, err
+	}
+	indexes := []SQLiteMasterRow{}
+	for _, row := range rows {
+		if strings.ToLower(row.Type) == "index" {
+			indexes = append(indexes, row)
+		}
+	}
+	result, err := ParseSQLiteMaster(rows, options)
+	return result.Tables, indexes, err
+}
+
+/*
+  ParsePgDumpArchive reads the table of contents of a pg_dump custom-format archive
+  (pg_dump -Fc) without pg_restore, and parses the DDL of its schemas, tables, sequences,
+  defaults, constraints, indexes and comments like ParseAll with PgDump.
+  The data blocks are not read. The indexes are returned as the entries whose Desc is "INDEX".
+*/
+func ParsePgDumpArchive(path string, options Options) (Result, []PgDumpEntry, error) {
+	entries, err := pgarchive.ReadFile(path)
+	if err != nil {
+		return Result{Tables: []Table{}}, []PgDumpEntry{}, err
+	}
+	ddl := ""
+	indexes := []PgDumpEntry{}
+	for _, entry := range entries {
+		if !common.Contains(pgDumpSchemaEntries, entry.Desc) {
+			continue
+		}
+		if entry.Desc == "INDEX" {
+			indexes = append(indexes, entry)
+		}
+		ddl += entry.Defn + "\n"
+	}
+	options.PgDump = true
+	result, err := ParseAll(ddl, PostgreSQL, options)
+	return result, indexes, err
+}
+
+var pgDumpSchemaEntries = []string{
+	"SCHEMA", "TABLE", "SEQUENCE", "SEQUENCE OWNED BY", "DEFAULT", "CONSTRAINT", 
+	"CHECK CONSTRAINT", "FK CONSTRAINT", "INDEX", "COMMENT",
+}
+
 func ParseSQLite(ddl string) ([]Table, error) {
 	return Parse(ddl, SQLite)
 }
@@ -73,4 +251,16 @@ func ParseForce(ddl string) ([]Table, error) {
 		}
 	}
 	return []Table{}, err
-}
\ No newline at end of file
+}
+/*
+  NormalizeTableName and NormalizeColumnName fold a name as the RDBMS does.
+  Names (e.g. Table.Name and Table.NameQuoted) refer to the same object
+  if their normalized names are equal.
+*/
+func NormalizeTableName(rdbms Rdbms, name string, quoted bool) string {
+	return common.NormalizeTableName(rdbms, name, quoted)
+}
+
+func NormalizeColumnName(rdbms Rdbms, name string, quoted bool) string {
+	return common.NormalizeColumnName(rdbms, name, quoted)
+}
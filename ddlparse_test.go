@@ -1,9 +1,14 @@
 package ddlparse
 
 import (
+	"os"
+	"errors"
+	"strings"
+	"bytes"
 	"runtime"
 	"testing"
 	"reflect"
+	"path/filepath"
 	"encoding/json"
 )
 
@@ -57,6 +62,7 @@ func TestParseSQlite(t *testing.T) {
 				"is_unique": false,
 				"is_not_null": false,
 				"is_autoincrement": true,
+				"is_rowid_alias": true,
 				"default": null,
 				"check": "",
 				"collate": "",
@@ -123,7 +129,28 @@ func TestParseSQlite(t *testing.T) {
 				"is_unique": false,
 				"is_not_null": true,
 				"is_autoincrement": false,
-				"default": "(DATETIME('now','localtime'))",
+				"default": "(DATETIME('now', 'localtime'))",
+				"default_value": {
+				  "kind": "expression",
+				  "value": "(DATETIME('now', 'localtime'))",
+				  "expression": {
+					"source": "DATETIME('now', 'localtime')",
+					"tree": {
+					  "kind": "function",
+					  "name": "DATETIME",
+					  "args": [
+						{
+						  "kind": "string",
+						  "value": "now"
+						},
+						{
+						  "kind": "string",
+						  "value": "localtime"
+						}
+					  ]
+					}
+				  }
+				},
 				"check": "",
 				"collate": "",
 				"references": {
@@ -145,7 +172,28 @@ func TestParseSQlite(t *testing.T) {
 				"is_unique": false,
 				"is_not_null": true,
 				"is_autoincrement": false,
-				"default": "(DATETIME('now','localtime'))",
+				"default": "(DATETIME('now', 'localtime'))",
+				"default_value": {
+				  "kind": "expression",
+				  "value": "(DATETIME('now', 'localtime'))",
+				  "expression": {
+					"source": "DATETIME('now', 'localtime')",
+					"tree": {
+					  "kind": "function",
+					  "name": "DATETIME",
+					  "args": [
+						{
+						  "kind": "string",
+						  "value": "now"
+						},
+						{
+						  "kind": "string",
+						  "value": "localtime"
+						}
+					  ]
+					}
+				  }
+				},
 				"check": "",
 				"collate": "",
 				"references": {
@@ -205,14 +253,15 @@ func TestParsePostgreSQL(t *testing.T) {
 				"is_primary_key": true,
 				"is_unique": false,
 				"is_not_null": false,
-				"is_autoincrement": false,
+				"is_autoincrement": true,
 				"default": null,
 				"check": "",
 				"collate": "",
 				"references": {
 				  "table_name": "",
 				  "column_names": null
-				}
+				},
+				"sequence": "users_user_id_seq"
 			  }
 			},
 			{
@@ -273,6 +322,7 @@ func TestParsePostgreSQL(t *testing.T) {
 				"is_not_null": true,
 				"is_autoincrement": false,
 				"default": "CURRENT_TIMESTAMP",
+				"default_value": {"kind": "keyword", "value": "CURRENT_TIMESTAMP"},
 				"check": "",
 				"collate": "",
 				"references": {
@@ -295,6 +345,7 @@ func TestParsePostgreSQL(t *testing.T) {
 				"is_not_null": true,
 				"is_autoincrement": false,
 				"default": "CURRENT_TIMESTAMP",
+				"default_value": {"kind": "keyword", "value": "CURRENT_TIMESTAMP"},
 				"check": "",
 				"collate": "",
 				"references": {
@@ -421,6 +472,7 @@ func TestParseMySQL(t *testing.T) {
 				"is_not_null": true,
 				"is_autoincrement": false,
 				"default": "CURRENT_TIMESTAMP",
+				"default_value": {"kind": "keyword", "value": "CURRENT_TIMESTAMP"},
 				"check": "",
 				"collate": "",
 				"references": {
@@ -443,6 +495,8 @@ func TestParseMySQL(t *testing.T) {
 				"is_not_null": true,
 				"is_autoincrement": false,
 				"default": "CURRENT_TIMESTAMP",
+				"default_value": {"kind": "keyword", "value": "CURRENT_TIMESTAMP"},
+				"on_update": "CURRENT_TIMESTAMP",
 				"check": "",
 				"collate": "",
 				"references": {
@@ -468,4 +522,1312 @@ func TestParseMySQL(t *testing.T) {
 
 	result, _ = ParseForce(ddl)
 	resultCheck(result, EXPECT_JSON, t)
+}
+
+
+func TestParseAllPostgreSQLSequence(t *testing.T) {
+	serialDdl := `
+	CREATE TABLE users (
+		id SERIAL PRIMARY KEY,
+		name TEXT
+	);`
+
+	dumpDdl := `
+	CREATE TABLE users (
+		id integer NOT NULL DEFAULT nextval('users_id_seq'::regclass) PRIMARY KEY,
+		name text
+	);
+
+	CREATE SEQUENCE users_id_seq
+		AS integer
+		START WITH 1
+		INCREMENT BY 1
+		NO MINVALUE
+		NO MAXVALUE
+		CACHE 1;
+
+	ALTER SEQUENCE users_id_seq OWNED BY users.id;`
+
+	serialResult, err := ParseAll(serialDdl, PostgreSQL, Options{ExpandSerial: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dumpResult, err := ParseAll(dumpDdl, PostgreSQL, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resultCheck(serialResult.Tables, toJson(dumpResult.Tables), t)
+
+	EXPECT_JSON := `[
+		{
+		  "schema": "",
+		  "name": "users_id_seq",
+		  "if_not_exists": false,
+		  "data_type": "INTEGER",
+		  "start": 1,
+		  "increment": 1,
+		  "cache": 1,
+		  "cycle": false,
+		  "owned_by": {
+			"schema": "",
+			"table_name": "users",
+			"column_name": "id"
+		  }
+		}
+	  ]`
+	sequencesCheck(dumpResult.Sequences, EXPECT_JSON, t)
+
+	EXPECT_JSON = `[
+		{
+		  "schema": "",
+		  "name": "users_id_seq",
+		  "if_not_exists": false,
+		  "data_type": "INTEGER",
+		  "cycle": false,
+		  "owned_by": {
+			"schema": "",
+			"table_name": "users",
+			"column_name": "id"
+		  }
+		}
+	  ]`
+	sequencesCheck(serialResult.Sequences, EXPECT_JSON, t)
+
+	result, _ := ParseAll(serialDdl, PostgreSQL, Options{})
+	if result.Tables[0].Columns[0].DataType.Name != "SERIAL" ||
+		!result.Tables[0].Columns[0].Constraint.IsAutoincrement ||
+		result.Tables[0].Columns[0].Constraint.Sequence != "users_id_seq" ||
+		len(result.Sequences) != 0 {
+		t.Errorf("failed: %s", toJson(result))
+	}
+
+	// the schema of the sequence is kept.
+	ddl := `
+	CREATE TABLE app.orders (
+		id integer DEFAULT nextval('app.orders_id_seq'::regclass),
+		code integer DEFAULT nextval('codes'),
+		no BIGSERIAL
+	);
+	CREATE TABLE app."Items" (id SERIAL);`
+	result, err = ParseAll(ddl, PostgreSQL, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sequences := [][]string{}
+	for _, table := range result.Tables {
+		for _, column := range table.Columns {
+			sequences = append(sequences, []string{column.Constraint.SequenceSchema, column.Constraint.Sequence})
+		}
+	}
+	expect := [][]string{{"app", "orders_id_seq"}, {"", "codes"}, {"app", "orders_no_seq"}, {"app", "Items_id_seq"}}
+	if !reflect.DeepEqual(sequences, expect) {
+		t.Errorf("failed: %v", sequences)
+	}
+
+	result, _ = ParseAll(ddl, PostgreSQL, Options{ExpandSerial: true})
+	if column := result.Tables[1].Columns[0]; column.Constraint.SequenceSchema != "app" || column.Constraint.Sequence != "Items_id_seq" {
+		t.Errorf("failed: %s", toJson(column))
+	}
+
+	// quoted names are quoted in nextval() and unquoted names are folded.
+	result, _ = ParseAll(`CREATE TABLE "Users" ("Id" SERIAL);`, PostgreSQL, Options{ExpandSerial: true})
+	if column := result.Tables[0].Columns[0]; column.Constraint.Default != `nextval('"Users_Id_seq"'::regclass)` ||
+		column.Constraint.Sequence != "Users_Id_seq" || result.Sequences[0].Name != "Users_Id_seq" || !result.Sequences[0].NameQuoted {
+		t.Errorf("failed: %s", toJson(result))
+	}
+	ddl = `
+	CREATE TABLE "Users" ("Id" integer DEFAULT nextval('public."Users_Id_seq"'::regclass));
+	CREATE TABLE users (id integer DEFAULT nextval('Public.Users_seq'));`
+	result, err = ParseAll(ddl, PostgreSQL, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sequences = [][]string{}
+	for _, table := range result.Tables {
+		constraint := table.Columns[0].Constraint
+		sequences = append(sequences, []string{constraint.SequenceSchema, constraint.Sequence})
+	}
+	if !reflect.DeepEqual(sequences, [][]string{{"public", "Users_Id_seq"}, {"public", "users_seq"}}) {
+		t.Errorf("failed: %v", sequences)
+	}
+}
+
+
+func TestParseAllKeepComments(t *testing.T) {
+	ddl := `
+	-- index on nothing
+	CREATE INDEX idx ON other (id);
+
+	-- users of the service
+	CREATE TABLE users ( -- login accounts
+		-- surrogate key
+		id INTEGER PRIMARY KEY, -- rowid alias
+		email TEXT NOT NULL, -- login id
+		/* display name,
+		   may be empty */
+		name TEXT,
+		UNIQUE (email), -- one account per email
+		-- last updated
+		updated_at TEXT -- ISO 8601
+		-- end of columns
+	); -- end of users`
+
+	docs := func(result Result) map[string]string {
+		ret := map[string]string{}
+		for _, table := range result.Tables {
+			ret[table.Name] = table.Doc
+			for _, column := range table.Columns {
+				ret[table.Name + "." + column.Name] = column.Doc
+			}
+		}
+		return ret
+	}
+
+	result, err := ParseAll(ddl, SQLite, Options{KeepComments: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := map[string]string{
+		"users": "users of the service\nlogin accounts",
+		"users.id": "surrogate key\nrowid alias",
+		"users.email": "login id",
+		"users.name": "display name,\nmay be empty",
+		"users.updated_at": "last updated\nISO 8601",
+	}
+	if !reflect.DeepEqual(docs(result), expect) {
+		t.Errorf("failed: %s", toJson(docs(result)))
+	}
+
+	result, err = ParseAll(ddl, SQLite, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for key, doc := range docs(result) {
+		if doc != "" {
+			t.Errorf("failed: %s: %s", key, doc)
+		}
+	}
+
+	ddl = `
+	# users
+	CREATE TABLE users (
+		id INT PRIMARY KEY, # id
+		email VARCHAR(255) NOT NULL COMMENT 'login id' -- login id
+	) ENGINE = InnoDB;`
+
+	result, err = ParseAll(ddl, MySQL, Options{KeepComments: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect = map[string]string{
+		"users": "users",
+		"users.id": "id",
+		"users.email": "login id",
+	}
+	if !reflect.DeepEqual(docs(result), expect) {
+		t.Errorf("failed: %s", toJson(docs(result)))
+	}
+
+	ddl = `
+	/* users */
+	CREATE TABLE users (
+		id integer /* id */ PRIMARY KEY,
+		name text -- name
+	);
+	COMMENT ON TABLE users IS 'users';`
+
+	result, err = ParseAll(ddl, PostgreSQL, Options{KeepComments: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect = map[string]string{
+		"users": "users",
+		"users.id": "id",
+		"users.name": "name",
+	}
+	if !reflect.DeepEqual(docs(result), expect) {
+		t.Errorf("failed: %s", toJson(docs(result)))
+	}
+}
+
+
+func TestParseDefaultValue(t *testing.T) {
+	ddl := `
+	CREATE TABLE t (
+		c1 INTEGER,
+		c2 INTEGER DEFAULT NULL,
+		c3 BIGINT DEFAULT 9223372036854775807,
+		c4 NUMERIC DEFAULT 0.10,
+		c5 TEXT DEFAULT '123',
+		c6 INTEGER DEFAULT 123,
+		c7 BOOLEAN DEFAULT FALSE,
+		c8 TIMESTAMP DEFAULT current_timestamp,
+		c9 TEXT DEFAULT lower('A'),
+		c10 INTEGER DEFAULT (1 + 2)
+	);`
+
+	tables, err := Parse(ddl, PostgreSQL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := []*DefaultValue{
+		nil,
+		{Kind: DefaultNull},
+		{Kind: DefaultNumber, Value: "9223372036854775807"},
+		{Kind: DefaultNumber, Value: "0.10"},
+		{Kind: DefaultString, Value: "123"},
+		{Kind: DefaultNumber, Value: "123"},
+		{Kind: DefaultBoolean, Value: "false"},
+		{Kind: DefaultKeyword, Value: "CURRENT_TIMESTAMP"},
+		{Kind: DefaultFunction, Value: "lower('A')", Expression: &Expression{
+			Source: "lower('A')",
+			Tree: &Expr{Kind: ExprFunction, Name: "lower", Args: []*Expr{{Kind: ExprString, Value: "A"}}},
+		}},
+		{Kind: DefaultExpression, Value: "(1 + 2)", Expression: &Expression{
+			Source: "1 + 2",
+			Tree: &Expr{
+				Kind: ExprBinary, Op: "+",
+				Left: &Expr{Kind: ExprNumber, Value: "1"},
+				Right: &Expr{Kind: ExprNumber, Value: "2"},
+			},
+		}},
+	}
+	for i, column := range tables[0].Columns {
+		if !reflect.DeepEqual(column.Constraint.DefaultValue, expect[i]) {
+			t.Errorf("failed: %s: %s", column.Name, toJson(column.Constraint.DefaultValue))
+		}
+	}
+
+	// numbers out of the range of int64 and float64 are kept as written.
+	ddl = `CREATE TABLE t (
+		c1 NUMERIC DEFAULT 12345678901234567890.5,
+		c2 NUMERIC DEFAULT 1e400,
+		c3 NUMERIC DEFAULT -1.5E-10
+	);`
+	for _, rdbms := range []Rdbms{PostgreSQL, MySQL, SQLite} {
+		tables, err := Parse(ddl, rdbms)
+		if err != nil {
+			t.Fatal(err)
+		}
+		values := []string{}
+		for _, column := range tables[0].Columns {
+			if column.Constraint.DefaultValue.Kind != DefaultNumber {
+				t.Errorf("failed: %s", toJson(column.Constraint.DefaultValue))
+			}
+			values = append(values, column.Constraint.DefaultValue.Value)
+		}
+		if !reflect.DeepEqual(values, []string{"12345678901234567890.5", "1e400", "-1.5E-10"}) {
+			t.Errorf("failed: %v", values)
+		}
+	}
+}
+
+
+func TestParseExpression(t *testing.T) {
+	column := func(name string) *Expr {
+		return &Expr{Kind: ExprColumn, Name: name}
+	}
+	number := func(value string) *Expr {
+		return &Expr{Kind: ExprNumber, Value: value}
+	}
+	binary := func(op string, left, right *Expr) *Expr {
+		return &Expr{Kind: ExprBinary, Op: op, Left: left, Right: right}
+	}
+
+	ddl := `
+	CREATE TABLE t (
+		c1 INTEGER CHECK(c1>=0),
+		c2 INTEGER CHECK (c1 + 1 * 2 > 0 AND NOT c2 IN (1, 2) OR c2 BETWEEN 1 AND 10),
+		c3 TEXT CHECK (c3::text LIKE 'x%' ESCAPE '!'),
+		c4 TEXT CHECK (CASE WHEN c1 > 0 THEN 'a' ELSE 'b' END = c4),
+		c5 NUMERIC GENERATED ALWAYS AS (CAST(c1 AS numeric(10,2)) * 1.5) STORED,
+		c6 INTEGER CHECK (EXISTS (SELECT 1)),
+		CHECK (c1 -1 < c2 AND c3 IS NOT NULL)
+	);`
+
+	tables, err := Parse(ddl, PostgreSQL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	columns := tables[0].Columns
+	expect := []*Expression{
+		{Source: "c1>=0", Tree: binary(">=", column("c1"), number("0"))},
+		{
+			Source: "c1 + 1 * 2 > 0 AND NOT c2 IN (1, 2) OR c2 BETWEEN 1 AND 10",
+			Tree: binary("OR",
+				binary("AND",
+					binary(">", binary("+", column("c1"), binary("*", number("1"), number("2"))), number("0")),
+					&Expr{Kind: ExprUnary, Op: "NOT", Operand: &Expr{
+						Kind: ExprIn, Operand: column("c2"), Args: []*Expr{number("1"), number("2")},
+					}},
+				),
+				&Expr{Kind: ExprBetween, Operand: column("c2"), Args: []*Expr{number("1"), number("10")}},
+			),
+		},
+		{
+			Source: "c3::text LIKE 'x%' ESCAPE '!'",
+			Tree: &Expr{
+				Kind: ExprLike, Op: "LIKE",
+				Left: &Expr{Kind: ExprCast, Operand: column("c3"), Type: "text"},
+				Right: &Expr{Kind: ExprString, Value: "x%"},
+				Escape: &Expr{Kind: ExprString, Value: "!"},
+			},
+		},
+		{
+			Source: "CASE WHEN c1 > 0 THEN 'a' ELSE 'b' END = c4",
+			Tree: binary("=", &Expr{
+				Kind: ExprCase,
+				Whens: []CaseWhen{{When: binary(">", column("c1"), number("0")), Then: &Expr{Kind: ExprString, Value: "a"}}},
+				Else: &Expr{Kind: ExprString, Value: "b"},
+			}, column("c4")),
+		},
+		nil,
+		// subqueries are not supported
+		{Source: "EXISTS (SELECT 1)"},
+	}
+	for i, expression := range expect {
+		if expression == nil {
+			continue
+		}
+		if !reflect.DeepEqual(columns[i].Constraint.CheckExpression, expression) {
+			t.Errorf("failed: %s: %s", columns[i].Name, toJson(columns[i].Constraint.CheckExpression))
+		}
+	}
+
+	generated := &Generated{
+		Expression: Expression{
+			Source: "CAST(c1 AS numeric(10,2)) * 1.5",
+			Tree: binary("*", &Expr{Kind: ExprCast, Operand: column("c1"), Type: "numeric(10,2)"}, number("1.5")),
+		},
+		Stored: true,
+	}
+	if !reflect.DeepEqual(columns[4].Constraint.Generated, generated) {
+		t.Errorf("failed: c5: %s", toJson(columns[4].Constraint.Generated))
+	}
+
+	check := tables[0].Constraints.Check[0]
+	expression := &Expression{
+		Source: "c1 -1 < c2 AND c3 IS NOT NULL",
+		Tree: binary("AND",
+			binary("<", binary("-", column("c1"), number("1")), column("c2")),
+			&Expr{Kind: ExprBinary, Op: "IS", Not: true, Left: column("c3"), Right: &Expr{Kind: ExprNull}},
+		),
+	}
+	if check.Expr != "(c1 -1 < c2 AND c3 IS NOT NULL)" || !reflect.DeepEqual(check.Expression, expression) {
+		t.Errorf("failed: check: %s", toJson(check))
+	}
+}
+
+
+func TestParseExpressionDialects(t *testing.T) {
+	ddl := "CREATE TABLE t (c1 INTEGER CHECK (c1 > 0 && c1 DIV 2 = 1 || c1 REGEXP '^1'), " +
+		"c2 INTEGER AS (c1 * 2) VIRTUAL COMMENT 'c2');"
+	tables, err := Parse(ddl, MySQL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tree := tables[0].Columns[0].Constraint.CheckExpression.Tree
+	if tree == nil || tree.Op != "||" || tree.Left.Op != "&&" || tree.Right.Kind != ExprLike {
+		t.Errorf("failed: %s", toJson(tree))
+	}
+	generated := tables[0].Columns[1].Constraint.Generated
+	if generated == nil || generated.Source != "c1 * 2" || generated.Stored {
+		t.Errorf("failed: %s", toJson(generated))
+	}
+
+	ddl = "CREATE TABLE t (c1 TEXT CHECK (c1 || 'x' == 'ax' AND c1 NOT NULL AND c1 GLOB 'a*'), " +
+		"c2 INTEGER GENERATED ALWAYS AS (abs(c1)) STORED);"
+	tables, err = Parse(ddl, SQLite)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tree = tables[0].Columns[0].Constraint.CheckExpression.Tree
+	if tree == nil || tree.Op != "AND" || tree.Left.Left.Left.Op != "||" || tree.Right.Op != "GLOB" {
+		t.Errorf("failed: %s", toJson(tree))
+	}
+	generated = tables[0].Columns[1].Constraint.Generated
+	if generated == nil || generated.Tree.Kind != ExprFunction || !generated.Stored {
+		t.Errorf("failed: %s", toJson(generated))
+	}
+}
+
+
+func TestParseQuotedNames(t *testing.T) {
+	ddl := `
+	CREATE TABLE "Users" ("Id" INTEGER, Name TEXT, "a""b" TEXT, PRIMARY KEY ("Id"));
+	CREATE TABLE Users (Id INTEGER);
+	COMMENT ON TABLE users IS 'folded';
+	COMMENT ON COLUMN "Users".name IS 'name';`
+
+	result, err := ParseAll(ddl, PostgreSQL, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	quoted, folded := result.Tables[0], result.Tables[1]
+	if quoted.Name != "Users" || !quoted.NameQuoted || folded.Name != "Users" || folded.NameQuoted {
+		t.Errorf("failed: %s, %s", toJson(quoted), toJson(folded))
+	}
+	if NormalizeTableName(PostgreSQL, quoted.Name, quoted.NameQuoted) != "Users" ||
+		NormalizeTableName(PostgreSQL, folded.Name, folded.NameQuoted) != "users" {
+		t.Errorf("failed: NormalizeTableName")
+	}
+	if quoted.Comment != "" || folded.Comment != "folded" {
+		t.Errorf("failed: comment: %s, %s", quoted.Comment, folded.Comment)
+	}
+	columns := quoted.Columns
+	if !columns[0].NameQuoted || columns[1].NameQuoted || columns[2].Name != "a\"b" || !columns[2].NameQuoted {
+		t.Errorf("failed: %s", toJson(columns))
+	}
+	if columns[1].Comment != "name" {
+		t.Errorf("failed: column comment: %s", toJson(columns[1]))
+	}
+	primaryKey := quoted.Constraints.PrimaryKey[0]
+	if !reflect.DeepEqual(primaryKey.ColumnNames, []string{"Id"}) || !reflect.DeepEqual(primaryKey.ColumnNamesQuoted, []bool{true}) {
+		t.Errorf("failed: %s", toJson(primaryKey))
+	}
+
+	tables, err := Parse("CREATE TABLE `T``1` (`Id` INT, id2 INT REFERENCES t2 (`Id`));", MySQL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	references := tables[0].Columns[1].Constraint.References
+	if tables[0].Name != "T`1" || !reflect.DeepEqual(references.ColumnNamesQuoted, []bool{true}) {
+		t.Errorf("failed: %s", toJson(tables[0]))
+	}
+	if NormalizeColumnName(MySQL, "Id", true) != "id" || NormalizeTableName(MySQL, "T`1", true) != "T`1" {
+		t.Errorf("failed: MySQL names")
+	}
+	if NormalizeTableName(SQLite, "Users", true) != "users" || NormalizeColumnName(SQLite, "ÄB", false) != "Äb" {
+		t.Errorf("failed: SQLite names")
+	}
+}
+
+
+func TestParseReferenceSchema(t *testing.T) {
+	ddls := map[Rdbms]string{
+		PostgreSQL: `CREATE TABLE t1 (c1 INTEGER REFERENCES billing.accounts (id), c2 INTEGER, 
+			FOREIGN KEY (c2) REFERENCES "Billing".accounts (id));`,
+		MySQL: "CREATE TABLE t1 (c1 INTEGER REFERENCES billing.accounts (id), c2 INTEGER, " +
+			"FOREIGN KEY (c2) REFERENCES `Billing`.accounts (id));",
+		SQLite: `CREATE TABLE t1 (c1 INTEGER REFERENCES billing.accounts (id), c2 INTEGER, 
+			FOREIGN KEY (c2) REFERENCES "Billing".accounts (id));`,
+	}
+	for rdbms, ddl := range ddls {
+		tables, err := Parse(ddl, rdbms)
+		if err != nil {
+			t.Fatal(rdbms, err)
+		}
+		expect := Reference{Schema: "billing", TableName: "accounts", ColumnNames: []string{"id"}}
+		if reference := tables[0].Columns[0].Constraint.References; !reflect.DeepEqual(reference, expect) {
+			t.Errorf("failed: %s: %s", rdbms, toJson(reference))
+		}
+		if len(tables[0].Columns) != 2 {
+			t.Errorf("failed: %s: %s", rdbms, toJson(tables[0].Columns))
+		}
+		expect = Reference{Schema: "Billing", SchemaQuoted: true, TableName: "accounts", ColumnNames: []string{"id"}}
+		if reference := tables[0].Constraints.ForeignKey[0].References; !reflect.DeepEqual(reference, expect) {
+			t.Errorf("failed: %s: %s", rdbms, toJson(reference))
+		}
+	}
+}
+
+
+func TestParseSchemaContext(t *testing.T) {
+	ddl := `
+	CREATE SCHEMA IF NOT EXISTS app AUTHORIZATION admin;
+	CREATE SCHEMA AUTHORIZATION "Owner";
+	SET client_encoding = 'UTF8';
+	CREATE TABLE t0 (id INTEGER);
+	SET search_path TO "$user", app, public;
+	CREATE TABLE t1 (id SERIAL PRIMARY KEY);
+	CREATE TABLE other.t2 (t1_id INTEGER REFERENCES t1 (id));
+	COMMENT ON TABLE t1 IS 'in app';
+	SET SESSION search_path = DEFAULT;
+	CREATE TABLE t3 (id INTEGER);`
+
+	result, err := ParseAll(ddl, PostgreSQL, Options{ExpandSerial: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := []Schema{
+		{Name: "app", IfNotExists: true, Authorization: "admin"},
+		{Name: "Owner", NameQuoted: true, Authorization: "Owner"},
+	}
+	if !reflect.DeepEqual(result.Schemas, expect) {
+		t.Errorf("failed: %s", toJson(result.Schemas))
+	}
+	schemas := []string{}
+	for _, table := range result.Tables {
+		schemas = append(schemas, table.Schema)
+	}
+	if !reflect.DeepEqual(schemas, []string{"", "app", "other", ""}) {
+		t.Errorf("failed: %v", schemas)
+	}
+	if result.Tables[1].Comment != "in app" {
+		t.Errorf("failed: comment: %s", toJson(result.Tables[1]))
+	}
+	if reference := result.Tables[2].Columns[0].Constraint.References; reference.Schema != "app" {
+		t.Errorf("failed: %s", toJson(reference))
+	}
+	if len(result.Sequences) != 1 || result.Sequences[0].Schema != "app" {
+		t.Errorf("failed: %s", toJson(result.Sequences))
+	}
+
+	// an unqualified name is looked up in the schemas of the search_path in order.
+	ddl = `
+	CREATE TABLE public.users (id INTEGER PRIMARY KEY);
+	SET search_path TO app, public;
+	CREATE TABLE orders (
+		uid INTEGER REFERENCES users (id),
+		parent INTEGER REFERENCES orders,
+		gid INTEGER REFERENCES groups
+	);
+	COMMENT ON TABLE users IS 'in public';`
+	result, err = ParseAll(ddl, PostgreSQL, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	users, orders := result.Tables[0], result.Tables[1]
+	if orders.Schema != "app" || users.Comment != "in public" {
+		t.Errorf("failed: %s", toJson(result.Tables))
+	}
+	schemas = []string{}
+	for _, column := range orders.Columns {
+		schemas = append(schemas, column.Constraint.References.Schema)
+	}
+	if !reflect.DeepEqual(schemas, []string{"public", "app", "app"}) {
+		t.Errorf("failed: %v", schemas)
+	}
+
+	ddl = "CREATE DATABASE IF NOT EXISTS `Shop` DEFAULT CHARACTER SET = utf8mb4 COLLATE utf8mb4_bin READ ONLY = 0;\n" +
+		"CREATE SCHEMA logs;\n" +
+		"USE `Shop`;\n" +
+		"CREATE TABLE users (id INT PRIMARY KEY);\n" +
+		"CREATE TABLE logs.entries (user_id INT, FOREIGN KEY (user_id) REFERENCES users (id));"
+	result, err = ParseAll(ddl, MySQL, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect = []Schema{
+		{Name: "Shop", NameQuoted: true, IfNotExists: true, Database: true, Charset: "utf8mb4", Collate: "utf8mb4_bin"},
+		{Name: "logs"},
+	}
+	if !reflect.DeepEqual(result.Schemas, expect) {
+		t.Errorf("failed: %s", toJson(result.Schemas))
+	}
+	users, entries := result.Tables[0], result.Tables[1]
+	if users.Schema != "Shop" || !users.SchemaQuoted || entries.Schema != "logs" {
+		t.Errorf("failed: %s, %s", users.Schema, entries.Schema)
+	}
+	// MySQL looks up an unqualified parent table in the database of the child table.
+	if reference := entries.Constraints.ForeignKey[0].References; reference.Schema != "logs" || reference.SchemaQuoted {
+		t.Errorf("failed: %s", toJson(reference))
+	}
+}
+
+
+func TestParseDrop(t *testing.T) {
+	ddl := `
+	DROP TABLE IF EXISTS orders, users CASCADE;
+	CREATE SCHEMA tmp;
+	CREATE TABLE tmp.t1 (id INTEGER);
+	CREATE TABLE users (id SERIAL PRIMARY KEY);
+	CREATE TABLE orders (id INTEGER, user_id INTEGER REFERENCES users (id), 
+		FOREIGN KEY (user_id) REFERENCES public.users (id));
+	CREATE SEQUENCE order_seq;
+	CREATE TABLE items (id INTEGER DEFAULT nextval('order_seq'));
+	DROP TABLE public.users CASCADE;
+	DROP SEQUENCE order_seq CASCADE;
+	DROP SCHEMA tmp CASCADE;
+	DROP INDEX IF EXISTS idx_users;
+	DROP VIEW v1;`
+
+	result, err := ParseAll(ddl, PostgreSQL, Options{ExpandSerial: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Tables) != 2 || result.Tables[0].Name != "orders" || result.Tables[1].Name != "items" {
+		t.Fatalf("failed: %s", toJson(result.Tables))
+	}
+	orders := result.Tables[0]
+	if orders.Columns[1].Constraint.References.TableName != "" || len(orders.Constraints.ForeignKey) != 0 {
+		t.Errorf("failed: %s", toJson(orders))
+	}
+	if constraint := result.Tables[1].Columns[0].Constraint; constraint.DefaultValue != nil || constraint.IsAutoincrement {
+		t.Errorf("failed: %s", toJson(constraint))
+	}
+	if len(result.Sequences) != 0 || len(result.Schemas) != 0 {
+		t.Errorf("failed: %s, %s", toJson(result.Sequences), toJson(result.Schemas))
+	}
+
+	ddl = "CREATE TABLE users (id INT, email VARCHAR(50) UNIQUE, code INT, PRIMARY KEY (id), UNIQUE KEY `uq_code` (code));\n" +
+		"CREATE TABLE orders (user_id INT REFERENCES users (id));\n" +
+		"DROP INDEX uq_code ON users;\n" +
+		"DROP INDEX email ON users;\n" +
+		"DROP INDEX `PRIMARY` ON users;\n" +
+		"DROP TABLE IF EXISTS orders CASCADE;"
+	tables, err := Parse(ddl, MySQL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tables) != 1 || len(tables[0].Constraints.Unique) != 0 || len(tables[0].Constraints.PrimaryKey) != 0 ||
+		tables[0].Columns[1].Constraint.IsUnique {
+		t.Errorf("failed: %s", toJson(tables))
+	}
+
+	tables, err = Parse("CREATE TABLE t1 (id INTEGER);\nCREATE TABLE t2 (id INTEGER);\nDROP TABLE IF EXISTS main.t1;\nDROP TABLE T2;", SQLite)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tables) != 0 {
+		t.Errorf("failed: %s", toJson(tables))
+	}
+}
+
+
+func TestParseCatalog(t *testing.T) {
+	ddl := `
+	CREATE SCHEMA app;
+	CREATE TABLE users (id INTEGER PRIMARY KEY, "Name" TEXT);
+	CREATE TABLE "Orders" (id SERIAL PRIMARY KEY, user_id INTEGER REFERENCES users);
+	CREATE TABLE app.items (
+		id INTEGER,
+		order_id INTEGER,
+		CONSTRAINT fk_order FOREIGN KEY (order_id) REFERENCES "Orders" (id),
+		CONSTRAINT fk_self FOREIGN KEY (id) REFERENCES app.items (id),
+		CONSTRAINT fk_none FOREIGN KEY (id) REFERENCES missing (id)
+	);`
+
+	catalog, err := ParseCatalog(ddl, PostgreSQL, Options{ExpandSerial: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := []string{}
+	for _, schema := range catalog.Schemas {
+		names = append(names, schema.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"app", ""}) || catalog.Schema("APP").Definition == nil {
+		t.Errorf("failed: %v", names)
+	}
+	users := catalog.Table("", "USERS")
+	if users == nil || catalog.Table("public", "users") != users || catalog.Table("", "\"USERS\"") != nil {
+		t.Fatalf("failed: users")
+	}
+	orders := catalog.Table("", "\"Orders\"")
+	if orders == nil || catalog.Table("", "orders") != nil {
+		t.Fatalf("failed: orders")
+	}
+	items := catalog.Schema("app").Table("items")
+	if items == nil || catalog.Table("", "items") != nil {
+		t.Fatalf("failed: items")
+	}
+	if catalog.Column(users, "\"Name\"") != &users.Columns[1] || catalog.Column(users, "name") != nil {
+		t.Errorf("failed: column")
+	}
+	if catalog.Sequence("", "\"Orders_id_seq\"") == nil || catalog.Sequence("", "orders_id_seq") != nil {
+		t.Errorf("failed: sequence")
+	}
+
+	outbound := catalog.OutboundRelations(orders)
+	if len(outbound) != 1 || outbound[0].ReferencedTable != users ||
+		!reflect.DeepEqual(outbound[0].ColumnNames, []string{"user_id"}) ||
+		!reflect.DeepEqual(outbound[0].ReferencedColumnNames, []string{"id"}) {
+		t.Errorf("failed: %s", toJson(outbound))
+	}
+	inbound := catalog.InboundRelations(users)
+	if len(inbound) != 1 || inbound[0].Table != orders {
+		t.Errorf("failed: %s", toJson(inbound))
+	}
+	outbound = catalog.OutboundRelations(items)
+	if len(outbound) != 3 || outbound[0].Name != "fk_order" || outbound[0].ReferencedTable != orders ||
+		outbound[1].ReferencedTable != items || outbound[2].ReferencedTable != nil {
+		t.Errorf("failed: %s", toJson(outbound))
+	}
+	if inbound := catalog.InboundRelations(orders); len(inbound) != 1 || inbound[0].Table != items {
+		t.Errorf("failed: %s", toJson(inbound))
+	}
+
+	ddl = "CREATE TABLE Shop.Users (Id INT PRIMARY KEY);\n" +
+		"CREATE TABLE Shop.orders (user_id INT REFERENCES Users (id));"
+	catalog, err = ParseCatalog(ddl, MySQL, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	users = catalog.Table("Shop", "`Users`")
+	if users == nil || catalog.Table("shop", "Users") != nil || catalog.Table("Shop", "users") != nil {
+		t.Fatalf("failed: MySQL tables")
+	}
+	if catalog.Column(users, "ID") != &users.Columns[0] {
+		t.Errorf("failed: MySQL column")
+	}
+	if inbound := catalog.InboundRelations(users); len(inbound) != 1 || inbound[0].Table.Name != "orders" {
+		t.Errorf("failed: %s", toJson(inbound))
+	}
+
+	ddl = "CREATE TABLE Users (id INTEGER PRIMARY KEY);\n" +
+		"CREATE TABLE [aux].orders (id INTEGER PRIMARY KEY);"
+	catalog, err = ParseCatalog(ddl, SQLite, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	users = catalog.Table("", "users")
+	if users == nil || catalog.Table("main", "users") != users || catalog.Table("[MAIN]", "users") != users || 
+		catalog.Table("public", "users") != nil {
+		t.Errorf("failed: SQLite main")
+	}
+	if catalog.Table("aux", "orders") == nil || catalog.Table("main", "orders") != nil {
+		t.Errorf("failed: SQLite aux")
+	}
+}
+
+
+func toJson(v interface{}) string {
+	jsonData, _ := json.MarshalIndent(v, "", "  ")
+	return string(jsonData)
+}
+
+
+func sequencesCheck(result []Sequence, expectJson string, t *testing.T) {
+	_, _, l, _ := runtime.Caller(1)
+
+	var map1, map2 []map[string]interface{}
+	json.Unmarshal([]byte(expectJson), &map1)
+	json.Unmarshal([]byte(toJson(result)), &map2)
+
+	if !reflect.DeepEqual(map1, map2) {
+		t.Errorf("%d: failed: \n%s", l, toJson(result))
+	}
+}
+
+
+func TestParseMySQLDump(t *testing.T) {
+	ddl := "/*!40101 SET @OLD_CHARACTER_SET_CLIENT=@@CHARACTER_SET_CLIENT */;\n" +
+		"/*!50503 SET NAMES utf8mb4 */;\n" +
+		"/*!40103 SET TIME_ZONE='+00:00' */;\n" +
+		"CREATE DATABASE /*!32312 IF NOT EXISTS*/ `shop` /*!40100 DEFAULT CHARACTER SET utf8mb4 */;\n" +
+		"USE `shop`;\n" +
+		"DROP TABLE IF EXISTS `users`;\n" +
+		"/*!40101 SET @saved_cs_client     = @@character_set_client */;\n" +
+		"CREATE TABLE `users` (\n" +
+		"  `id` int unsigned NOT NULL AUTO_INCREMENT,\n" +
+		"  `name` varchar(50) DEFAULT NULL,\n" +
+		"  `status` enum('active','banned') NOT NULL DEFAULT 'active',\n" +
+		"  PRIMARY KEY (`id`),\n" +
+		"  KEY `idx_users_name` (`name`(10)) USING BTREE,\n" +
+		"  FULLTEXT KEY `ft_users_name` (`name`)\n" +
+		") ENGINE=InnoDB AUTO_INCREMENT=3 DEFAULT CHARSET=utf8mb4 /*!80000 COMMENT='users' */;\n" +
+		"LOCK TABLES `users` WRITE;\n" +
+		"/*!40000 ALTER TABLE `users` DISABLE KEYS */;\n" +
+		"INSERT INTO `users` VALUES (1,'O\\'Brien','active'),(2,'a;b\\\\','banned');\n" +
+		"/*!40000 ALTER TABLE `users` ENABLE KEYS */;\n" +
+		"UNLOCK TABLES;\n" +
+		"/*!50003 SET @saved_sql_mode = @@sql_mode */ ;\n" +
+		"DELIMITER ;;\n" +
+		"/*!50003 CREATE*/ /*!50017 DEFINER=`root`@`localhost`*/ /*!50003 TRIGGER `trg` BEFORE INSERT ON `users` FOR EACH ROW BEGIN\n" +
+		"  SET NEW.name = TRIM(NEW.name);\n" +
+		"END */;;\n" +
+		"DELIMITER ;\n" +
+		"/*!40101 SET CHARACTER_SET_CLIENT=@OLD_CHARACTER_SET_CLIENT */;\n"
+
+	if _, err := Parse(ddl, MySQL); err == nil {
+		t.Errorf("failed: mysqldump output is accepted without MySQLDump")
+	}
+
+	result, err := ParseAll(ddl, MySQL, Options{MySQLDump: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Tables) != 1 || len(result.Schemas) != 1 || result.Schemas[0].Charset != "utf8mb4" {
+		t.Fatalf("failed: %s, %s", toJson(result.Tables), toJson(result.Schemas))
+	}
+	users := result.Tables[0]
+	if users.Schema != "shop" || len(users.Columns) != 3 || len(users.Constraints.PrimaryKey) != 1 {
+		t.Errorf("failed: %s", toJson(users))
+	}
+	if values := users.Columns[2].DataType.Values; len(values) != 2 || values[0] != "active" || values[1] != "banned" {
+		t.Errorf("failed: %s", toJson(users.Columns[2]))
+	}
+
+	result, err = ParseAll(ddl, MySQL, Options{MySQLDump: true, MySQLVersion: 40000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Schemas) != 1 || !result.Schemas[0].IfNotExists || result.Schemas[0].Charset != "" {
+		t.Errorf("failed: %s", toJson(result.Schemas))
+	}
+
+	if _, err := ParseAll("/*!40101 SET NAMES utf8mb4;", MySQL, Options{MySQLDump: true}); err == nil {
+		t.Errorf("failed: unclosed versioned comment")
+	}
+}
+
+func TestParsePgDump(t *testing.T) {
+	ddl := "\\restrict abc123\n" +
+		"SET statement_timeout = 0;\n" +
+		"SET client_encoding = 'UTF8';\n" +
+		"SELECT pg_catalog.set_config('search_path', '', false);\n" +
+		"CREATE EXTENSION IF NOT EXISTS pgcrypto WITH SCHEMA public;\n" +
+		"COMMENT ON EXTENSION pgcrypto IS 'cryptographic functions';\n" +
+		"CREATE FUNCTION public.touch() RETURNS trigger\n" +
+		"    LANGUAGE plpgsql\n" +
+		"    AS $$\nBEGIN\n  NEW.note := 'it''s;';\n  RETURN NEW;\nEND;\n$$;\n" +
+		"ALTER FUNCTION public.touch() OWNER TO postgres;\n" +
+		"CREATE TABLE public.users (\n" +
+		"    id integer NOT NULL,\n" +
+		"    email character varying(255) NOT NULL,\n" +
+		"    status character varying(20) DEFAULT 'active'::character varying NOT NULL\n" +
+		");\n" +
+		"ALTER TABLE public.users OWNER TO postgres;\n" +
+		"CREATE SEQUENCE public.users_id_seq AS integer START WITH 1 INCREMENT BY 1 NO MINVALUE NO MAXVALUE CACHE 1;\n" +
+		"ALTER TABLE public.users_id_seq OWNER TO postgres;\n" +
+		"ALTER SEQUENCE public.users_id_seq OWNED BY public.users.id;\n" +
+		"CREATE TABLE public.orders (\n" +
+		"    id bigint NOT NULL,\n" +
+		"    user_id integer NOT NULL\n" +
+		");\n" +
+		"ALTER TABLE public.orders ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY (\n" +
+		"    SEQUENCE NAME public.orders_id_seq\n" +
+		"    START WITH 1\n" +
+		");\n" +
+		"ALTER TABLE ONLY public.users ALTER COLUMN id SET DEFAULT nextval('public.users_id_seq'::regclass);\n" +
+		"COPY public.users (id, email, status) FROM stdin;\n" +
+		"1\ta@example.com\tactive\n" +
+		"2\tO'Brien; DROP TABLE users\t\\N\n" +
+		"\\.\n" +
+		"SELECT pg_catalog.setval('public.users_id_seq', 2, true);\n" +
+		"ALTER TABLE ONLY public.users\n" +
+		"    ADD CONSTRAINT users_pkey PRIMARY KEY (id);\n" +
+		"ALTER TABLE ONLY public.users\n" +
+		"    ADD CONSTRAINT users_email_key UNIQUE (email);\n" +
+		"CREATE INDEX idx_orders_user_id ON public.orders USING btree (user_id);\n" +
+		"ALTER TABLE ONLY public.orders\n" +
+		"    ADD CONSTRAINT orders_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;\n" +
+		"REVOKE USAGE ON SCHEMA public FROM PUBLIC;\n" +
+		"GRANT ALL ON SCHEMA public TO PUBLIC;\n" +
+		"\\unrestrict abc123\n"
+
+	if _, err := Parse(ddl, PostgreSQL); err == nil {
+		t.Errorf("failed: pg_dump output is accepted without PgDump")
+	}
+
+	result, err := ParseAll(ddl, PostgreSQL, Options{PgDump: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Tables) != 2 || len(result.Sequences) != 1 || result.Sequences[0].OwnedBy == nil {
+		t.Fatalf("failed: %s, %s", toJson(result.Tables), toJson(result.Sequences))
+	}
+	users, orders := result.Tables[0], result.Tables[1]
+	if constraints := users.Constraints; len(constraints.PrimaryKey) != 1 || constraints.PrimaryKey[0].Name != "users_pkey" || 
+		len(constraints.Unique) != 1 || constraints.Unique[0].ColumnNames[0] != "email" {
+		t.Errorf("failed: %s", toJson(users.Constraints))
+	}
+	if constraint := users.Columns[0].Constraint; !constraint.IsAutoincrement || constraint.Sequence != "users_id_seq" {
+		t.Errorf("failed: %s", toJson(constraint))
+	}
+	if value := users.Columns[2].Constraint.DefaultValue; value == nil || value.Kind != "string" || value.Value != "active" {
+		t.Errorf("failed: %s", toJson(users.Columns[2]))
+	}
+	if identity := orders.Columns[0].Constraint.Identity; identity == nil || !identity.Always || *identity.Start != 1 {
+		t.Errorf("failed: %s", toJson(orders.Columns[0]))
+	}
+	if foreignKeys := orders.Constraints.ForeignKey; len(foreignKeys) != 1 || 
+		foreignKeys[0].References.Schema != "public" || foreignKeys[0].References.TableName != "users" {
+		t.Errorf("failed: %s", toJson(orders.Constraints))
+	}
+
+	if _, err := ParseAll("COPY public.users (id) FROM stdin;\n1\n", PostgreSQL, Options{PgDump: true}); err == nil {
+		t.Errorf("failed: COPY data without \\.")
+	}
+}
+
+func TestParseSQLiteMaster(t *testing.T) {
+	rows := []SQLiteMasterRow{
+		{Type: "table", Name: "users", TblName: "users", SQL: "CREATE TABLE users(id integer primary key autoincrement, name text not null unique, note)"},
+		{Type: "index", Name: "sqlite_autoindex_users_1", TblName: "users", SQL: ""},
+		{Type: "table", Name: "sqlite_sequence", TblName: "sqlite_sequence", SQL: "CREATE TABLE sqlite_sequence(name,seq)"},
+		{Type: "table", Name: "docs", TblName: "docs", SQL: "CREATE VIRTUAL TABLE docs USING fts5(title, body)"},
+		{Type: "table", Name: "docs_config", TblName: "docs_config", SQL: "CREATE TABLE 'docs_config'(k PRIMARY KEY, v) WITHOUT ROWID"},
+		{Type: "index", Name: "idx_users_name", TblName: "users", SQL: "CREATE INDEX idx_users_name ON users(name)"},
+		{Type: "trigger", Name: "trg", TblName: "users", SQL: "CREATE TRIGGER trg AFTER INSERT ON users BEGIN UPDATE users SET note = 'x' WHERE id = NEW.id; END"},
+	}
+
+	result, err := ParseSQLiteMaster(rows, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Tables) != 4 {
+		t.Fatalf("failed: %s", toJson(result.Tables))
+	}
+	users, sequence, docs, config := result.Tables[0], result.Tables[1], result.Tables[2], result.Tables[3]
+	if users.Internal || len(users.Columns) != 3 || users.Columns[2].Name != "note" || users.Columns[2].DataType.Name != "" {
+		t.Errorf("failed: %s", toJson(users))
+	}
+	if !sequence.Internal || len(sequence.Columns) != 2 || sequence.Columns[1].Name != "seq" {
+		t.Errorf("failed: %s", toJson(sequence))
+	}
+	if !docs.Virtual || docs.Internal || docs.Module != "fts5" || len(docs.Columns) != 0 ||
+		!reflect.DeepEqual(docs.ModuleArguments, []string{"title", "body"}) {
+		t.Errorf("failed: %s", toJson(docs))
+	}
+	if config.Name != "docs_config" || !config.NameQuoted || !config.Internal || 
+		!config.Columns[0].Constraint.IsPrimaryKey || !config.WithoutRowid {
+		t.Errorf("failed: %s", toJson(config))
+	}
+}
+
+func TestParseSQLiteMasterRows(t *testing.T) {
+	rows := []SQLiteMasterRow{
+		{Type: "table", Name: "user list", TblName: "user list", SQL: "CREATE TABLE [user list]([id] integer primary key, [full name] text)"},
+		{Type: "table", Name: "broken", TblName: "broken", SQL: "CREATE TABLE broken(id integer primary key primary)"},
+		{Type: "table", Name: "posts", TblName: "posts", SQL: "CREATE TABLE posts(id integer, user_id integer references [user list]([id]))"},
+	}
+
+	result, err := ParseSQLiteMaster(rows, Options{})
+	var validateError ValidateError
+	if err == nil || !strings.HasPrefix(err.Error(), "broken: ") || !errors.As(err, &validateError) {
+		t.Errorf("failed: %v", err)
+	}
+	if len(result.Tables) != 2 {
+		t.Fatalf("failed: %s", toJson(result.Tables))
+	}
+	users, posts := result.Tables[0], result.Tables[1]
+	if users.Name != "user list" || !users.NameQuoted || users.Columns[1].Name != "full name" || !users.Columns[1].NameQuoted || 
+		!users.Columns[0].Constraint.IsRowidAlias {
+		t.Errorf("failed: %s", toJson(users))
+	}
+	if reference := posts.Columns[1].Constraint.References; reference.TableName != "user list" || !reference.TableNameQuoted {
+		t.Errorf("failed: %s", toJson(posts))
+	}
+}
+
+func TestParseSQLiteSchema(t *testing.T) {
+	schema := "CREATE TABLE users(id integer primary key autoincrement, name text);\n" +
+		"CREATE TABLE sqlite_sequence(name,seq);\n" +
+		"CREATE VIRTUAL TABLE docs using fts5(title, body)\n" +
+		"/* docs(title,body) */;\n" +
+		"CREATE TABLE IF NOT EXISTS 'docs_data'(id INTEGER PRIMARY KEY, block BLOB);\n" +
+		"CREATE VIEW v as select * from users\n" +
+		"/* v(id,name) */;\n" +
+		"CREATE TABLE sqlite_stat1(tbl,idx,stat);\n"
+
+	if _, err := Parse(schema, SQLite); err == nil {
+		t.Errorf("failed: 'docs_data' is accepted without SQLiteSchema")
+	}
+
+	result, err := ParseSQLiteSchema(schema, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Tables) != 5 {
+		t.Fatalf("failed: %s", toJson(result.Tables))
+	}
+	if docs := result.Tables[2]; docs.Name != "docs" || !docs.Virtual || docs.Module != "fts5" {
+		t.Errorf("failed: %s", toJson(docs))
+	}
+	if data := result.Tables[3]; data.Name != "docs_data" || !data.NameQuoted || !data.IfNotExists || !data.Internal {
+		t.Errorf("failed: %s", toJson(data))
+	}
+	if stat := result.Tables[4]; !stat.Internal || len(stat.Columns) != 3 {
+		t.Errorf("failed: %s", toJson(stat))
+	}
+
+	result, err = ParseSQLiteSchema("CREATE TABLE users(id integer)", Options{})
+	if err != nil || len(result.Tables) != 1 {
+		t.Errorf("failed: %v, %s", err, toJson(result.Tables))
+	}
+
+	schema = "CREATE TABLE ev (at TIMESTAMP, payload JSON not null, u UUID, n unsigned big int, d varying character(10, 2));\n" +
+		"CREATE TABLE s (x ANY) STRICT;\n"
+	result, err = ParseSQLiteSchema(schema, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := []string{}
+	for _, column := range append(result.Tables[0].Columns, result.Tables[1].Columns...) {
+		names = append(names, column.DataType.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"TIMESTAMP", "JSON", "UUID", "UNSIGNED BIG INT", "VARYING CHARACTER", "ANY"}) ||
+		!result.Tables[0].Columns[1].Constraint.IsNotNull || result.Tables[0].Columns[4].DataType.DigitM != 2 {
+		t.Errorf("failed: %s", toJson(result.Tables))
+	}
+}
+
+func TestParseSQLiteFile(t *testing.T) {
+	tables, indexes, err := ParseSQLiteFile("test/testdata/rollback.db", Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tables) != 12 || len(indexes) != 20 {
+		t.Fatalf("failed: %d tables, %d indexes", len(tables), len(indexes))
+	}
+	wide, sequence := tables[0], tables[1]
+	if wide.Name != "wide" || len(wide.Columns) != 21 || !wide.Columns[0].Constraint.IsAutoincrement {
+		t.Errorf("failed: %s", toJson(wide))
+	}
+	if value := wide.Columns[20].Constraint.DefaultValue; value == nil || value.Value != "value 19" {
+		t.Errorf("failed: %s", toJson(wide.Columns[20]))
+	}
+	if sequence.Name != "sqlite_sequence" || !sequence.Internal {
+		t.Errorf("failed: %s", toJson(sequence))
+	}
+	if index := indexes[len(indexes) - 1]; index.Name != "idx_t09_name" || index.TblName != "t09" || 
+		index.SQL != "CREATE INDEX idx_t09_name ON t09(name, wide_id)" {
+		t.Errorf("failed: %s", toJson(index))
+	}
+
+	tables, indexes, err = ParseSQLiteFile("test/testdata/wal.db", Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tables) != 3 || len(indexes) != 1 || tables[0].Name != "ユーザー" || !tables[0].NameQuoted || 
+		tables[0].Columns[1].Name != "名前" || !tables[2].Internal {
+		t.Errorf("failed: %s, %s", toJson(tables), toJson(indexes))
+	}
+
+	if _, _, err := ParseSQLiteFile("README.md", Options{}); err == nil {
+		t.Errorf("failed: not a SQLite database is accepted")
+	}
+
+	// sqlite3 creates a 0-byte file for a new database.
+	path := filepath.Join(t.TempDir(), "empty.db")
+	if err := os.WriteFile(path, []byte{}, 0644); err != nil {
+		t.Fatal(err)
+	}
+	tables, indexes, err = ParseSQLiteFile(path, Options{})
+	if err != nil || tables == nil || len(tables) != 0 || len(indexes) != 0 {
+		t.Errorf("failed: %v, %s, %s", err, toJson(tables), toJson(indexes))
+	}
+}
+
+/*
+  Write a pg_dump custom-format archive (archive version 1.minor) as pg_backup_archiver.c does:
+  the header, the TOC and a data block that is not read.
+*/
+func writePgArchive(t *testing.T, minor int, entries []PgDumpEntry) string {
+	var buf bytes.Buffer
+	writeInt := func(i int) {
+		sign := byte(0)
+		if i < 0 {
+			sign, i = 1, -i
+		}
+		buf.WriteByte(sign)
+		for b := 0; b < 4; b++ {
+			buf.WriteByte(byte(i >> (b * 8)))
+		}
+	}
+	writeStr := func(s string) {
+		writeInt(len(s))
+		buf.WriteString(s)
+	}
+
+	buf.WriteString("PGDMP")
+	buf.Write([]byte{1, byte(minor), 0, 4}) // version, int size
+	if minor >= 7 {
+		buf.WriteByte(8) // offset size
+	}
+	buf.WriteByte(1) // custom format
+	if minor >= 15 {
+		buf.WriteByte(1) // gzip
+	} else {
+		writeInt(-1) // Z_DEFAULT_COMPRESSION
+	}
+	for _, i := range []int{0, 30, 12, 19, 9, 126, 0} {
+		writeInt(i)
+	}
+	writeStr("shop")
+	if minor >= 10 {
+		writeStr("17.2")
+		writeStr("17.2")
+	}
+
+	writeInt(len(entries))
+	for _, entry := range entries {
+		writeInt(entry.DumpID)
+		writeInt(0) // hadDumper
+		if minor >= 8 {
+			writeStr("1259")
+		}
+		writeStr("16384")
+		writeStr(entry.Tag)
+		writeStr(entry.Desc)
+		if minor >= 11 {
+			writeInt(2) // section
+		}
+		writeStr(entry.Defn)
+		writeStr("") // dropStmt
+		writeStr("") // copyStmt
+		writeStr(entry.Namespace)
+		if minor >= 10 {
+			writeStr("") // tablespace
+		}
+		if minor >= 14 {
+			writeStr("heap")
+		}
+		if minor >= 16 {
+			writeInt('r')
+		}
+		writeStr(entry.Owner)
+		if minor >= 9 {
+			writeStr("false")
+		}
+		writeStr("1")
+		writeInt(-1) // end of dependencies
+		if minor >= 7 {
+			buf.Write([]byte{3, 0, 0, 0, 0, 0, 0, 0, 0}) // no data
+		} else {
+			writeInt(0) // no data
+			writeInt(0) // data size
+		}
+	}
+	buf.Write([]byte{1, 0, 0, 0, 0, 0x78, 0x9c, 0x03, 0x00})
+
+	path := filepath.Join(t.TempDir(), "dump.pgdump")
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestParsePgDumpArchive(t *testing.T) {
+	entries := []PgDumpEntry{
+		{DumpID: 1, Desc: "ENCODING", Tag: "ENCODING", Defn: "SET client_encoding = 'UTF8';\n"},
+		{DumpID: 2, Desc: "SEARCHPATH", Tag: "SEARCHPATH", Defn: "SELECT pg_catalog.set_config('search_path', '', false);\n"},
+		{DumpID: 3, Desc: "SCHEMA", Tag: "app", Owner: "postgres", Defn: "CREATE SCHEMA app;\n"},
+		{DumpID: 4, Desc: "FUNCTION", Tag: "touch()", Namespace: "public", Owner: "postgres", 
+			Defn: "CREATE FUNCTION public.touch() RETURNS trigger\n    LANGUAGE plpgsql\n    AS $$\nBEGIN\n  RETURN NEW;\nEND;\n$$;\n"},
+		{DumpID: 5, Desc: "TABLE", Tag: "users", Namespace: "public", Owner: "postgres", 
+			Defn: "CREATE TABLE public.users (\n    id integer NOT NULL,\n    email character varying(255) NOT NULL,\n    tags text[]\n);\n"},
+		{DumpID: 6, Desc: "COMMENT", Tag: "TABLE users", Namespace: "public", Owner: "postgres", 
+			Defn: "COMMENT ON TABLE public.users IS 'registered users';\n"},
+		{DumpID: 7, Desc: "SEQUENCE", Tag: "users_id_seq", Namespace: "public", Owner: "postgres", 
+			Defn: "CREATE SEQUENCE public.users_id_seq\n    AS integer\n    START WITH 1\n    INCREMENT BY 1\n    NO MINVALUE\n    NO MAXVALUE\n    CACHE 1;\n"},
+		{DumpID: 8, Desc: "SEQUENCE OWNED BY", Tag: "users_id_seq", Namespace: "public", Owner: "postgres", 
+			Defn: "ALTER SEQUENCE public.users_id_seq OWNED BY public.users.id;\n"},
+		{DumpID: 9, Desc: "TABLE", Tag: "orders", Namespace: "app", Owner: "postgres", 
+			Defn: "CREATE TABLE app.orders (\n    id bigint NOT NULL,\n    user_id integer NOT NULL\n);\n"},
+		{DumpID: 10, Desc: "SEQUENCE", Tag: "orders_id_seq", Namespace: "app", Owner: "postgres", 
+			Defn: "ALTER TABLE app.orders ALTER COLUMN id ADD GENERATED ALWAYS AS IDENTITY (\n    SEQUENCE NAME app.orders_id_seq\n    START WITH 1\n    INCREMENT BY 1\n    NO MINVALUE\n    NO MAXVALUE\n    CACHE 1\n);\n"},
+		{DumpID: 11, Desc: "DEFAULT", Tag: "users id", Namespace: "public", Owner: "postgres", 
+			Defn: "ALTER TABLE ONLY public.users ALTER COLUMN id SET DEFAULT nextval('public.users_id_seq'::regclass);\n"},
+		{DumpID: 12, Desc: "TABLE DATA", Tag: "users", Namespace: "public", Owner: "postgres"},
+		{DumpID: 13, Desc: "SEQUENCE SET", Tag: "users_id_seq", Namespace: "public", Owner: "postgres", 
+			Defn: "SELECT pg_catalog.setval('public.users_id_seq', 2, true);\n"},
+		{DumpID: 14, Desc: "CONSTRAINT", Tag: "users users_pkey", Namespace: "public", Owner: "postgres", 
+			Defn: "ALTER TABLE ONLY public.users\n    ADD CONSTRAINT users_pkey PRIMARY KEY (id);\n"},
+		{DumpID: 15, Desc: "INDEX", Tag: "users_email_idx", Namespace: "public", Owner: "postgres", 
+			Defn: "CREATE UNIQUE INDEX users_email_idx ON public.users USING btree (lower((email)::text));\n"},
+		{DumpID: 16, Desc: "FK CONSTRAINT", Tag: "orders orders_user_id_fkey", Namespace: "app", Owner: "postgres", 
+			Defn: "ALTER TABLE ONLY app.orders\n    ADD CONSTRAINT orders_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;\n"},
+		{DumpID: 17, Desc: "ACL", Tag: "TABLE users", Namespace: "public", Owner: "postgres", 
+			Defn: "GRANT SELECT ON TABLE public.users TO reader;\n"},
+	}
+
+	for _, minor := range []int{6, 10, 14, 15, 16} {
+		result, indexes, err := ParsePgDumpArchive(writePgArchive(t, minor, entries), Options{})
+		if err != nil {
+			t.Fatalf("1.%d: %v", minor, err)
+		}
+		if len(result.Schemas) != 1 || len(result.Tables) != 2 || len(result.Sequences) != 1 {
+			t.Fatalf("1.%d failed: %s", minor, toJson(result))
+		}
+		users, orders := result.Tables[0], result.Tables[1]
+		if users.Schema != "public" || users.Comment != "registered users" || len(users.Constraints.PrimaryKey) != 1 || 
+			users.Columns[2].DataType.Dimensions != 1 {
+			t.Errorf("1.%d failed: %s", minor, toJson(users))
+		}
+		if constraint := users.Columns[0].Constraint; !constraint.IsAutoincrement || constraint.Sequence != "users_id_seq" {
+			t.Errorf("1.%d failed: %s", minor, toJson(constraint))
+		}
+		if identity := orders.Columns[0].Constraint.Identity; orders.Schema != "app" || identity == nil || !identity.Always {
+			t.Errorf("1.%d failed: %s", minor, toJson(orders))
+		}
+		if foreignKeys := orders.Constraints.ForeignKey; len(foreignKeys) != 1 || foreignKeys[0].References.Schema != "public" {
+			t.Errorf("1.%d failed: %s", minor, toJson(orders.Constraints))
+		}
+		if len(indexes) != 1 || indexes[0].Tag != "users_email_idx" || indexes[0].Namespace != "public" {
+			t.Errorf("1.%d failed: %s", minor, toJson(indexes))
+		}
+	}
+
+	path := writePgArchive(t, 16, entries)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, data[:len(data) / 2], 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := ParsePgDumpArchive(path, Options{}); err == nil {
+		t.Errorf("failed: truncated archive is accepted")
+	}
+	if _, _, err := ParsePgDumpArchive("README.md", Options{}); err == nil {
+		t.Errorf("failed: not a pg_dump archive is accepted")
+	}
+}
+
+func TestParseLike(t *testing.T) {
+	ddl := `CREATE TABLE users (
+		id SERIAL,
+		code BIGSERIAL,
+		name TEXT,
+		PRIMARY KEY (name)
+	);
+	CREATE TABLE users_copy (LIKE users);
+	CREATE TABLE users_defaults (LIKE users INCLUDING DEFAULTS);
+	CREATE TABLE users_names AS SELECT name FROM users;`
+
+	result, err := ParseAll(ddl, PostgreSQL, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	copied, defaults, names := result.Tables[1], result.Tables[2], result.Tables[3]
+	if id := copied.Columns[0]; id.DataType.Name != "INTEGER" || id.Constraint.IsAutoincrement || !id.Constraint.IsNotNull || id.Constraint.Default != nil || id.Constraint.Sequence != "" {
+		t.Errorf("failed: %s", toJson(id))
+	}
+	if code := copied.Columns[1]; code.DataType.Name != "BIGINT" || !code.Constraint.IsNotNull {
+		t.Errorf("failed: %s", toJson(code))
+	}
+	if name := copied.Columns[2]; name.Constraint.IsPrimaryKey || !name.Constraint.IsNotNull || len(copied.Constraints.PrimaryKey) != 0 {
+		t.Errorf("failed: %s", toJson(copied))
+	}
+	if id := defaults.Columns[0]; id.DataType.Name != "INTEGER" || !id.Constraint.IsAutoincrement || 
+		id.Constraint.Default != "nextval('users_id_seq'::regclass)" || id.Constraint.DefaultValue == nil || id.Constraint.Sequence != "users_id_seq" {
+		t.Errorf("failed: %s", toJson(id))
+	}
+	if names.Columns == nil || len(names.Columns) != 0 {
+		t.Errorf("failed: %s", toJson(names))
+	}
+}
+
+func TestParseLikeComments(t *testing.T) {
+	ddl := `CREATE TABLE users (
+		-- the login name
+		name TEXT
+	);
+	COMMENT ON COLUMN users.name IS 'login name';
+	CREATE TABLE users_copy (LIKE users);
+	CREATE TABLE users_comments (LIKE users INCLUDING COMMENTS);`
+
+	result, err := ParseAll(ddl, PostgreSQL, Options{KeepComments: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	copied, comments := result.Tables[1], result.Tables[2]
+	if name := copied.Columns[0]; name.Comment != "" || name.Doc != "" {
+		t.Errorf("failed: %s", toJson(name))
+	}
+	if name := comments.Columns[0]; name.Comment != "login name" || name.Doc != "the login name" {
+		t.Errorf("failed: %s", toJson(name))
+	}
+
+	ddl = "CREATE TABLE users (name TEXT COMMENT 'login name');\nCREATE TABLE users_copy LIKE users;"
+	result, err = ParseAll(ddl, MySQL, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name := result.Tables[1].Columns[0]; name.Comment != "login name" {
+		t.Errorf("failed: %s", toJson(name))
+	}
 }
\ No newline at end of file
@@ -0,0 +1,320 @@
+package catalog
+
+import (
+	"strings"
+
+	"github.com/kodaimura/ddlparse/internal/types"
+	"github.com/kodaimura/ddlparse/internal/common"
+)
+
+
+/*
+////////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////////
+
+  New():
+    Group the objects of a Result by schema.
+    The tables and sequences are not copied: the catalog points to the ones in the Result.
+
+  Lookups take names as written in SQL. A quoted name ("Users" or `Users`) is matched
+  as a quoted identifier, so the case rules of the RDBMS apply (see common.NormalizeTableName).
+
+////////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////////
+*/
+
+type Catalog struct {
+	Rdbms common.Rdbms `json:"rdbms"`
+	Schemas []*Namespace `json:"schemas"`
+	relations []Relation
+}
+
+/*
+  Namespace holds the objects of one schema (database in MySQL).
+  Objects without a schema are in the Namespace named "".
+  Definition is the CREATE SCHEMA (or CREATE DATABASE), if the input has one.
+*/
+type Namespace struct {
+	Name string `json:"name"`
+	NameQuoted bool `json:"name_quoted,omitempty"`
+	Definition *types.Schema `json:"definition,omitempty"`
+	Tables []*types.Table `json:"tables"`
+	Sequences []*types.Sequence `json:"sequences"`
+	rdbms common.Rdbms
+}
+
+/*
+  Relation is a foreign key from Table to ReferencedTable,
+  given as a table constraint (FOREIGN KEY) or a column constraint (REFERENCES).
+  ReferencedTable is nil if the referenced table is not in the catalog.
+  ReferencedColumnNames are the primary key of ReferencedTable if the REFERENCES has no column names.
+*/
+type Relation struct {
+	Table *types.Table `json:"-"`
+	types.ForeignKey
+	ReferencedTable *types.Table `json:"-"`
+	ReferencedColumnNames []string `json:"referenced_column_names"`
+}
+
+
+func New(rdbms common.Rdbms, result types.Result) *Catalog {
+	c := &Catalog{Rdbms: rdbms, Schemas: []*Namespace{}}
+	for i := range result.Schemas {
+		schema := &result.Schemas[i]
+		c.namespace(schema.Name, schema.NameQuoted).Definition = schema
+	}
+	for i := range result.Tables {
+		table := &result.Tables[i]
+		n := c.namespace(table.Schema, table.SchemaQuoted)
+		n.Tables = append(n.Tables, table)
+	}
+	for i := range result.Sequences {
+		sequence := &result.Sequences[i]
+		n := c.namespace(sequence.Schema, sequence.SchemaQuoted)
+		n.Sequences = append(n.Sequences, sequence)
+	}
+	for i := range result.Tables {
+		c.relations = append(c.relations, c.tableRelations(&result.Tables[i])...)
+	}
+	return c
+}
+
+
+// namespace returns the Namespace of the name, adding it if there is none.
+func (c *Catalog) namespace(name string, quoted bool) *Namespace {
+	if n := c.findNamespace(name, quoted); n != nil {
+		return n
+	}
+	n := &Namespace{
+		Name: name,
+		NameQuoted: quoted,
+		Tables: []*types.Table{},
+		Sequences: []*types.Sequence{},
+		rdbms: c.Rdbms,
+	}
+	c.Schemas = append(c.Schemas, n)
+	return n
+}
+
+
+func (c *Catalog) findNamespace(name string, quoted bool) *Namespace {
+	for _, n := range c.Schemas {
+		if c.equalTableName(n.Name, n.NameQuoted, name, quoted) {
+			return n
+		}
+	}
+	return nil
+}
+
+
+func (c *Catalog) equalTableName(name1 string, quoted1 bool, name2 string, quoted2 bool) bool {
+	return common.NormalizeTableName(c.Rdbms, name1, quoted1) == common.NormalizeTableName(c.Rdbms, name2, quoted2)
+}
+
+
+// Schema returns the Namespace of the schema, or nil.
+// "" is the Namespace of the objects without a schema.
+func (c *Catalog) Schema(name string) *Namespace {
+	name, quoted := unquoteName(c.Rdbms, name)
+	return c.findNamespace(name, quoted)
+}
+
+
+/*
+  Table returns the table schema.name, or nil.
+  A table without a schema is also found in the default schema
+  (PostgreSQL: public.name, SQLite: main.name).
+*/
+func (c *Catalog) Table(schema, name string) *types.Table {
+	schemaName, schemaQuoted := unquoteName(c.Rdbms, schema)
+	tableName, tableQuoted := unquoteName(c.Rdbms, name)
+	return c.findTable(schemaName, schemaQuoted, tableName, tableQuoted)
+}
+
+
+func (c *Catalog) findTable(schema string, schemaQuoted bool, name string, quoted bool) *types.Table {
+	if n := c.findNamespace(schema, schemaQuoted); n != nil {
+		if table := n.findTable(name, quoted); table != nil {
+			return table
+		}
+	}
+	if schema != "" && common.IsDefaultSchema(c.Rdbms, schema, schemaQuoted) {
+		return c.findTable("", false, name, quoted)
+	}
+	return nil
+}
+
+
+// Sequence returns the sequence schema.name, or nil.
+func (c *Catalog) Sequence(schema, name string) *types.Sequence {
+	if n := c.Schema(schema); n != nil {
+		return n.Sequence(name)
+	}
+	return nil
+}
+
+
+// Column returns the column of the table, or nil.
+func (c *Catalog) Column(table *types.Table, name string) *types.Column {
+	return findColumn(c.Rdbms, table, name)
+}
+
+
+// OutboundRelations returns the foreign keys of the table.
+func (c *Catalog) OutboundRelations(table *types.Table) []Relation {
+	relations := []Relation{}
+	for _, relation := range c.relations {
+		if relation.Table == table {
+			relations = append(relations, relation)
+		}
+	}
+	return relations
+}
+
+
+// InboundRelations returns the foreign keys that reference the table.
+func (c *Catalog) InboundRelations(table *types.Table) []Relation {
+	relations := []Relation{}
+	for _, relation := range c.relations {
+		if relation.ReferencedTable == table {
+			relations = append(relations, relation)
+		}
+	}
+	return relations
+}
+
+
+// The column constraints (REFERENCES) first, then the table constraints (FOREIGN KEY).
+func (c *Catalog) tableRelations(table *types.Table) []Relation {
+	relations := []Relation{}
+	for _, column := range table.Columns {
+		constraint := column.Constraint
+		if constraint.References.TableName == "" {
+			continue
+		}
+		var foreignKey types.ForeignKey
+		foreignKey.Name = constraint.ReferencesName
+		foreignKey.ColumnNames = []string{column.Name}
+		if column.NameQuoted {
+			foreignKey.ColumnNamesQuoted = []bool{true}
+		}
+		foreignKey.References = constraint.References
+		relations = append(relations, c.relation(table, foreignKey))
+	}
+	for _, foreignKey := range table.Constraints.ForeignKey {
+		relations = append(relations, c.relation(table, foreignKey))
+	}
+	return relations
+}
+
+
+/*
+  A REFERENCES target without a schema is in the schema of the referencing table in MySQL and SQLite.
+  In PostgreSQL the converter has already qualified it by the schema of the search_path
+  where the table was found (the first schema if none); without SET search_path it stays empty.
+*/
+func (c *Catalog) relation(table *types.Table, foreignKey types.ForeignKey) Relation {
+	relation := Relation{Table: table, ForeignKey: foreignKey}
+	reference := foreignKey.References
+	schema, schemaQuoted := reference.Schema, reference.SchemaQuoted
+	if schema == "" && c.Rdbms != common.PostgreSQL {
+		schema, schemaQuoted = table.Schema, table.SchemaQuoted
+	}
+	relation.ReferencedTable = c.findTable(schema, schemaQuoted, reference.TableName, reference.TableNameQuoted)
+	relation.ReferencedColumnNames = reference.ColumnNames
+	if len(relation.ReferencedColumnNames) == 0 && relation.ReferencedTable != nil {
+		relation.ReferencedColumnNames = primaryKeyColumnNames(relation.ReferencedTable)
+	}
+	return relation
+}
+
+
+func primaryKeyColumnNames(table *types.Table) []string {
+	if len(table.Constraints.PrimaryKey) > 0 {
+		return table.Constraints.PrimaryKey[0].ColumnNames
+	}
+	names := []string{}
+	for _, column := range table.Columns {
+		if column.Constraint.IsPrimaryKey {
+			names = append(names, column.Name)
+		}
+	}
+	return names
+}
+
+
+// Table returns the table of the name in the schema, or nil.
+// If the table is defined more than once, the last one is returned.
+func (n *Namespace) Table(name string) *types.Table {
+	name, quoted := unquoteName(n.rdbms, name)
+	return n.findTable(name, quoted)
+}
+
+
+func (n *Namespace) findTable(name string, quoted bool) *types.Table {
+	for i := len(n.Tables) - 1; i >= 0; i-- {
+		table := n.Tables[i]
+		if common.NormalizeTableName(n.rdbms, table.Name, table.NameQuoted) == common.NormalizeTableName(n.rdbms, name, quoted) {
+			return table
+		}
+	}
+	return nil
+}
+
+
+// Sequence returns the sequence of the name in the schema, or nil.
+func (n *Namespace) Sequence(name string) *types.Sequence {
+	name, quoted := unquoteName(n.rdbms, name)
+	for i := len(n.Sequences) - 1; i >= 0; i-- {
+		sequence := n.Sequences[i]
+		if common.NormalizeTableName(n.rdbms, sequence.Name, sequence.NameQuoted) == common.NormalizeTableName(n.rdbms, name, quoted) {
+			return sequence
+		}
+	}
+	return nil
+}
+
+
+func findColumn(rdbms common.Rdbms, table *types.Table, name string) *types.Column {
+	if table == nil {
+		return nil
+	}
+	name, quoted := unquoteName(rdbms, name)
+	for i := range table.Columns {
+		column := &table.Columns[i]
+		if common.NormalizeColumnName(rdbms, column.Name, column.NameQuoted) == common.NormalizeColumnName(rdbms, name, quoted) {
+			return column
+		}
+	}
+	return nil
+}
+
+
+// The name without quotes and whether it was quoted by the identifier quotes of the RDBMS.
+func unquoteName(rdbms common.Rdbms, name string) (string, bool) {
+	if len(name) < 2 {
+		return name, false
+	}
+	if rdbms == common.SQLite && strings.HasPrefix(name, "[") && strings.HasSuffix(name, "]") {
+		return name[1 : len(name)-1], true
+	}
+	q := name[0:1]
+	if q != name[len(name)-1:] {
+		return name, false
+	}
+	switch (rdbms) {
+		case common.SQLite:
+			if q != "\"" && q != "`" {
+				return name, false
+			}
+		case common.MySQL:
+			if q != "`" {
+				return name, false
+			}
+		case common.PostgreSQL:
+			if q != "\"" {
+				return name, false
+			}
+	}
+	return strings.ReplaceAll(name[1 : len(name)-1], q + q, q), true
+}
@@ -0,0 +1,46 @@
+package common
+
+import (
+	"strings"
+)
+
+
+/*
+  Comment tokens are kept by the lexer when Options.KeepComments is set.
+  The validator marks a comment that follows a token on the same line
+  as trailing by prefixing it with a space.
+  Space tokens (runs of spaces and tabs) are kept by the lexer created with options.
+  Comments, spaces and "\n" are trivia: they are not part of the syntax.
+*/
+
+func IsTriviaToken(token string) bool {
+	return token == "\n" || IsSpaceToken(token) || IsCommentToken(token)
+}
+
+func IsSpaceToken(token string) bool {
+	return token != "" && strings.Trim(token, " \t") == ""
+}
+
+func IsCommentToken(token string) bool {
+	token = strings.TrimPrefix(token, " ")
+	return strings.HasPrefix(token, "--") || strings.HasPrefix(token, "/*")
+}
+
+func TrailingComment(token string) string {
+	return " " + token
+}
+
+func IsTrailingComment(token string) bool {
+	return strings.HasPrefix(token, " ")
+}
+
+// CommentText strips the comment markers: "-- text" and "/* text */" become "text".
+// Each line of a multi-line comment is trimmed.
+func CommentText(token string) string {
+	token = strings.TrimPrefix(token, " ")
+	if strings.HasPrefix(token, "--") {
+		return strings.TrimSpace(token[2:])
+	}
+	lines := strings.Split(strings.TrimSpace(token[2 : len(token)-2]), "\n")
+	return strings.Join(MapSlice(lines, strings.TrimSpace), "\n")
+}
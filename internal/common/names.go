@@ -0,0 +1,61 @@
+package common
+
+import (
+	"strings"
+)
+
+
+/*
+  Names are kept as written without quotes, with a flag telling whether they were quoted.
+  The normalized name follows the case folding of each RDBMS,
+  so that two names refer to the same object if their normalized names are equal.
+    PostgreSQL: unquoted names are folded to lower case. Quoted names are case-sensitive.
+    SQLite: names are case-insensitive (ASCII only), quoted or not.
+    MySQL: column names are case-insensitive.
+      Table (and schema) names are case-sensitive (lower_case_table_names = 0).
+*/
+
+func NormalizeTableName(rdbms Rdbms, name string, quoted bool) string {
+	switch (rdbms) {
+		case PostgreSQL:
+			if quoted {
+				return name
+			}
+			return toLowerASCII(name)
+		case SQLite:
+			return toLowerASCII(name)
+	}
+	return name
+}
+
+func NormalizeColumnName(rdbms Rdbms, name string, quoted bool) string {
+	switch (rdbms) {
+		case MySQL:
+			return strings.ToLower(name)
+	}
+	return NormalizeTableName(rdbms, name, quoted)
+}
+
+/*
+  The schema of the objects created without a schema:
+    PostgreSQL: public (the first schema of the default search_path).
+    SQLite: main.
+*/
+func IsDefaultSchema(rdbms Rdbms, schema string, quoted bool) bool {
+	switch (rdbms) {
+		case PostgreSQL:
+			return NormalizeTableName(rdbms, schema, quoted) == "public"
+		case SQLite:
+			return NormalizeTableName(rdbms, schema, quoted) == "main"
+	}
+	return false
+}
+
+func toLowerASCII(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'A' && r <= 'Z' {
+			return r + ('a' - 'A')
+		}
+		return r
+	}, s)
+}
@@ -0,0 +1,27 @@
+package common
+
+
+type Options struct {
+	// PostgreSQL: expand SMALLSERIAL/SERIAL/BIGSERIAL columns into
+	// an integer column with an owned sequence and a nextval() default.
+	ExpandSerial bool
+	// Keep "--" and "/* */" comments and attach them to the table or
+	// column they belong to (Table.Doc, Column.Doc).
+	KeepComments bool
+	// MySQL: accept the whole output of mysqldump (SET, LOCK TABLES, INSERT,
+	// DELIMITER blocks, ...) and run the versioned comments (/*!40101 ... */)
+	// whose version is not greater than MySQLVersion.
+	MySQLDump bool
+	// MySQL: the server version as written in the versioned comments
+	// (e.g. 80032 for 8.0.32). 0 is DefaultMySQLVersion.
+	MySQLVersion int
+	// PostgreSQL: accept the whole output of pg_dump in plain format
+	// (SET, SELECT pg_catalog.set_config(...), COPY ... FROM stdin data, GRANT, psql meta-commands, ...).
+	PgDump bool
+	// SQLite: accept the schema as SQLite keeps it (sqlite_master.sql, the .schema
+	// output of the sqlite3 shell): the last statement may omit ";" and the shadow
+	// tables of virtual tables may be named in single quotes.
+	SQLiteSchema bool
+}
+
+const DefaultMySQLVersion = 80400
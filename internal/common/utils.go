@@ -1,7 +1,9 @@
 package common
 
 import (
+	"errors"
 	"strconv"
+	"strings"
 )
 
 func Filter(slice []string, f func(string) bool) []string {
@@ -51,7 +53,27 @@ func IsPositiveIntegerToken(token string) bool {
 	return n > 0
 }
 
+func IsIntegerToken(token string) bool {
+	_, err := strconv.ParseInt(token, 10, 64)
+	return err == nil
+}
+
+// A number out of the range of float64 (1e400) is still a number.
 func IsNumericToken(token string) bool {
 	_, err := strconv.ParseFloat(token, 64)
-	return err == nil
-}
\ No newline at end of file
+	return err == nil || errors.Is(err, strconv.ErrRange)
+}
+
+// JoinTokens joins tokens with spaces, except around "(", ")", "," and ".".
+func JoinTokens(tokens []string) string {
+	var sb strings.Builder
+	prev := ""
+	for i, token := range tokens {
+		if i > 0 && prev != "(" && prev != "." && token != ")" && token != "," && token != "." {
+			sb.WriteString(" ")
+		}
+		sb.WriteString(token)
+		prev = token
+	}
+	return sb.String()
+}
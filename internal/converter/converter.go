@@ -10,7 +10,7 @@ import (
 
 
 type Converter interface {
-	Convert(tokens []string) []types.Table
+	Convert(tokens []string) types.Result
 }
 
 /*
@@ -18,7 +18,8 @@ type Converter interface {
 ////////////////////////////////////////////////////////////////////////////////////
 
   Convert(): 
-    Convert the validated token to List of Table object.
+    Convert the validated token to List of Table object
+	(and the other objects such as Sequence).
 
 ////////////////////////////////////////////////////////////////////////////////////
 ////////////////////////////////////////////////////////////////////////////////////
@@ -26,10 +27,17 @@ type Converter interface {
 
 type converter struct {
 	rdbms common.Rdbms
+	options common.Options
 	tokens []string
+	trivia map[int][]string
 	size int
 	i int
-	result []types.Table
+	result types.Result
+	// the default schema set by USE or SET search_path.
+	schema string
+	schemaQuoted bool
+	// PostgreSQL: the schemas of SET search_path in order (schema and schemaQuoted are set).
+	searchPath []qualifiedName
 }
 
 
@@ -38,18 +46,37 @@ func NewConverter(rdbms common.Rdbms) Converter {
 }
 
 
-func (c *converter) Convert(tokens []string) []types.Table {
+func NewConverterWithOptions(rdbms common.Rdbms, options common.Options) Converter {
+	return &converter{rdbms: rdbms, options: options}
+}
+
+
+func (c *converter) Convert(tokens []string) types.Result {
 	c.init(tokens)
 	c.convert()
+	c.linkSequences()
+	c.markShadowTables()
 	return c.result
 }
 
 
 func (c *converter) init(tokens []string) {
-	c.tokens = tokens
+	// trivia (comments, spaces and "\n") are taken out of the tokens 
+	// and kept by the index of the token they precede.
+	c.tokens = []string{}
+	c.trivia = map[int][]string{}
+	for _, token := range tokens {
+		if common.IsTriviaToken(token) {
+			c.trivia[len(c.tokens)] = append(c.trivia[len(c.tokens)], token)
+		} else {
+			c.tokens = append(c.tokens, token)
+		}
+	}
 	c.size = len(c.tokens)
 	c.i = 0
-	c.result = []types.Table{}
+	c.result = types.Result{Tables: []types.Table{}}
+	c.schema, c.schemaQuoted = "", false
+	c.searchPath = nil
 }
 
 
@@ -61,6 +88,14 @@ func (c *converter) token() string {
 }
 
 
+func (c *converter) peek() string {
+	if c.i + 1 > c.size - 1 {
+		return common.EOF
+	}
+	return c.tokens[c.i + 1]
+}
+
+
 func (c *converter) isOutOfRange() bool {
 	return c.i > c.size - 1
 }
@@ -92,7 +127,7 @@ func (c *converter) isIdentifier(token string) bool {
 	tc := token[0:1]
 	switch (c.rdbms) {
 		case common.SQLite:
-			return tc == "\"" || tc == "`"
+			return tc == "\"" || tc == "`" || tc == "["
 		case common.MySQL:
 			return tc == "`"
 		case common.PostgreSQL:
@@ -119,9 +154,26 @@ func (c *converter) isStringValue(token string) bool {
 func (c *converter) convert() {
 	if c.isOutOfRange() {
 		return
+	}
+	if c.matchToken("ALTER") {
+		c.convertAlter()
+	} else if c.matchToken("COMMENT") {
+		c.convertCommentOn()
+	} else if c.matchToken("DROP") {
+		c.convertDrop()
+	} else if c.matchToken("USE") {
+		c.convertUse()
+	} else if c.matchToken("SET") {
+		c.convertSearchPath()
+	} else if strings.ToUpper(c.peek()) == "SCHEMA" || strings.ToUpper(c.peek()) == "DATABASE" {
+		schema := c.convertSchema()
+		c.result.Schemas = append(c.result.Schemas, schema)
+	} else if strings.ToUpper(c.peek()) == "SEQUENCE" {
+		sequence := c.convertSequence()
+		c.result.Sequences = append(c.result.Sequences, sequence)
 	} else {
 		table := c.convertTable()
-		c.result = append(c.result, table)
+		c.result.Tables = append(c.result.Tables, table)
 	}
 	c.convert()
 }
@@ -129,7 +181,18 @@ func (c *converter) convert() {
 
 func (c *converter) convertTable() types.Table {
 	var table types.Table
+	table.Doc = c.convertDoc(table.Doc, c.leadingComments(c.i))
 	c.next() // skip "CREATE"
+	if c.matchToken("TEMPORARY") {
+		c.next() // skip "TEMPORARY"
+		table.Temporary = true
+	} else if c.matchToken("UNLOGGED") {
+		c.next() // skip "UNLOGGED"
+		table.Unlogged = true
+	} else if c.matchToken("VIRTUAL") {
+		c.next() // skip "VIRTUAL"
+		table.Virtual = true
+	}
 	c.next() // skip "TABLE"
 
 	if c.matchToken("IF") {
@@ -139,84 +202,1306 @@ func (c *converter) convertTable() types.Table {
 		table.IfNotExists = true
 	}
 
-	schemaName, tableName := c.convertTableName()
-	columns, constraints := c.convertTableDefinition();
+	name := c.qualify(c.convertTableName())
+	table.Schema, table.SchemaQuoted = name.schema, name.schemaQuoted
+	table.Name, table.NameQuoted = name.name, name.quoted
 
-	table.Schema = schemaName
-	table.Name = tableName
-	table.Columns = columns
-	table.Constraints = constraints
+	if table.Virtual {
+		c.convertModule(&table)
+	} else if c.isCreateTableAs() {
+		c.convertCreateTableAs(&table)
+	} else {
+		c.convertTableDefinition(&table)
+	}
+
+	if c.matchToken("WITHOUT") {
+		c.next() // skip "WITHOUT"
+		c.next() // skip "ROWID"
+		table.WithoutRowid = true
+	}
+	if c.matchToken("ON") {
+		c.convertOnCommit(&table)
+	}
+	for c.matchToken("COMMENT") {
+		c.next() // skip "COMMENT"
+		table.Comment = c.convertStringValue()
+	}
+
+	if c.rdbms == common.PostgreSQL && c.options.ExpandSerial {
+		c.expandSerial(&table)
+	}
+	if c.rdbms == common.SQLite {
+		c.markRowidAlias(&table)
+		table.Internal = c.isInternalTable(table.Name)
+	}
+	c.qualifyReferences(&table)
 
 	if (c.size > c.i) {
 		if c.matchToken(";") {
 			c.next()
 		}
 	}
-	return table
+	return table
+}
+
+
+func (c *converter) convertSequence() types.Sequence {
+	var sequence types.Sequence
+	c.next() // skip "CREATE"
+	c.next() // skip "SEQUENCE"
+
+	if c.matchToken("IF") {
+		c.next() // skip "IF"
+		c.next() // skip "NOT"
+		c.next() // skip "EXISTS"
+		sequence.IfNotExists = true
+	}
+
+	name := c.qualify(c.convertTableName())
+	sequence.Schema, sequence.SchemaQuoted = name.schema, name.schemaQuoted
+	sequence.Name, sequence.NameQuoted = name.name, name.quoted
+	c.convertSequenceAux(&sequence)
+	c.next() // skip ";"
+	return sequence
+}
+
+
+func (c *converter) convertSequenceAux(sequence *types.Sequence) {
+	sequence.SequenceOptions = c.mergeSequenceOptions(sequence.SequenceOptions, c.convertSequenceOptions())
+	if c.matchToken("OWNED") {
+		c.next() // skip "OWNED"
+		c.next() // skip "BY"
+		if c.matchToken("NONE") {
+			c.next() // skip "NONE"
+			sequence.OwnedBy = nil
+		} else {
+			sequence.OwnedBy = c.convertSequenceOwner()
+		}
+		c.convertSequenceAux(sequence)
+	}
+}
+
+
+// [schema_name.]table_name.column_name
+func (c *converter) convertSequenceOwner() *types.SequenceOwner {
+	var owner types.SequenceOwner
+	names, quoted := c.convertDottedNames()
+	if len(names) == 3 {
+		owner.Schema, owner.SchemaQuoted = names[0], quoted[0]
+	} else {
+		owner.Schema, owner.SchemaQuoted = c.schema, c.schemaQuoted
+	}
+	n := len(names)
+	owner.TableName, owner.TableNameQuoted = names[n - 2], quoted[n - 2]
+	owner.ColumnName, owner.ColumnNameQuoted = names[n - 1], quoted[n - 1]
+	return &owner
+}
+
+
+func (c *converter) mergeSequenceOptions(dst, src types.SequenceOptions) types.SequenceOptions {
+	if src.DataType != "" {
+		dst.DataType = src.DataType
+	}
+	if src.Start != nil {
+		dst.Start = src.Start
+	}
+	if src.Increment != nil {
+		dst.Increment = src.Increment
+	}
+	if src.MinValue != nil {
+		dst.MinValue = src.MinValue
+	}
+	if src.MaxValue != nil {
+		dst.MaxValue = src.MaxValue
+	}
+	if src.Cache != nil {
+		dst.Cache = src.Cache
+	}
+	dst.Cycle = dst.Cycle || src.Cycle
+	return dst
+}
+
+
+func (c *converter) convertAlter() {
+	c.next() // skip "ALTER"
+	if c.matchToken("SEQUENCE") {
+		c.convertAlterSequence()
+	} else if c.matchToken("TABLE") {
+		c.convertAlterTable()
+	}
+}
+
+
+/*
+  ALTER TABLE applies to the table defined earlier, 
+  so that the constraints added after CREATE TABLE (as pg_dump does) are in the table.
+*/
+func (c *converter) convertAlterTable() {
+	c.next() // skip "TABLE"
+	table := &types.Table{}
+	if i := c.resolveTable(c.convertTableName()); i >= 0 {
+		table = &c.result.Tables[i]
+	}
+	c.convertAlterTableAction(table)
+	for c.matchToken(",") {
+		c.next() // skip ","
+		c.convertAlterTableAction(table)
+	}
+	c.next() // skip ";"
+}
+
+
+func (c *converter) convertAlterTableAction(table *types.Table) {
+	if c.matchToken("ADD") {
+		c.next() // skip "ADD"
+		added := types.Table{Schema: table.Schema, SchemaQuoted: table.SchemaQuoted}
+		c.convertTableConstraint(&added.Constraints)
+		c.qualifyReferences(&added)
+		constraints := &table.Constraints
+		constraints.PrimaryKey = append(constraints.PrimaryKey, added.Constraints.PrimaryKey...)
+		constraints.Unique = append(constraints.Unique, added.Constraints.Unique...)
+		constraints.Check = append(constraints.Check, added.Constraints.Check...)
+		constraints.ForeignKey = append(constraints.ForeignKey, added.Constraints.ForeignKey...)
+		constraints.Exclude = append(constraints.Exclude, added.Constraints.Exclude...)
+		return
+	}
+	if !c.matchToken("ALTER") {
+		return
+	}
+	c.next() // skip "ALTER"
+	c.next() // skip "COLUMN"
+	name, quoted := c.convertIdentifier()
+	column := &types.Column{}
+	for i := range table.Columns {
+		if c.equalColumnName(table.Columns[i].Name, table.Columns[i].NameQuoted, name, quoted) {
+			column = &table.Columns[i]
+		}
+	}
+	if !c.matchToken("DROP") {
+		c.next() // skip "SET" or "ADD"
+		c.convertConstraintAux(column)
+		return
+	}
+	c.next() // skip "DROP"
+	if c.matchToken("NOT") {
+		c.next() // skip "NOT"
+		c.next() // skip "NULL"
+		column.Constraint.IsNotNull = false
+		return
+	}
+	c.next() // skip "DEFAULT"
+	column.Constraint.Default = nil
+	column.Constraint.DefaultValue = nil
+	column.Constraint.DefaultName = ""
+}
+
+
+func (c *converter) convertAlterSequence() {
+	c.next() // skip "SEQUENCE"
+	name := c.qualify(c.convertTableName())
+	var sequence *types.Sequence
+	for i := range c.result.Sequences {
+		s := &c.result.Sequences[i]
+		if !c.equalTableName(s.Name, s.NameQuoted, name.name, name.quoted) {
+			continue
+		}
+		if name.schema == "" || s.Schema == "" || c.equalTableName(s.Schema, s.SchemaQuoted, name.schema, name.schemaQuoted) {
+			sequence = s
+		}
+	}
+	if sequence == nil {
+		sequence = &types.Sequence{}
+	}
+	c.convertSequenceAux(sequence)
+	c.next() // skip ";"
+}
+
+
+// COMMENT ON TABLE ... IS ... and COMMENT ON COLUMN ... IS ... apply to tables defined earlier.
+func (c *converter) convertCommentOn() {
+	c.next() // skip "COMMENT"
+	c.next() // skip "ON"
+	isColumn := strings.ToUpper(c.next()) == "COLUMN"
+	names, quoted := c.convertDottedNames()
+	c.next() // skip "IS"
+	comment := ""
+	if c.matchToken("NULL") {
+		c.next() // skip "NULL"
+	} else {
+		comment = c.convertStringValue()
+	}
+	c.next() // skip ";"
+
+	columnName, columnQuoted := "", false
+	if isColumn {
+		columnName, columnQuoted = names[len(names)-1], quoted[len(quoted)-1]
+		names, quoted = names[:len(names)-1], quoted[:len(quoted)-1]
+	}
+	name := qualifiedName{name: names[len(names)-1], quoted: quoted[len(quoted)-1]}
+	if len(names) > 1 {
+		name.schema, name.schemaQuoted = names[0], quoted[0]
+	}
+	i := c.resolveTable(name)
+	if i < 0 {
+		return
+	}
+	table := &c.result.Tables[i]
+	if !isColumn {
+		table.Comment = comment
+		return
+	}
+	for i := range table.Columns {
+		column := &table.Columns[i]
+		if c.equalColumnName(column.Name, column.NameQuoted, columnName, columnQuoted) {
+			column.Comment = comment
+		}
+	}
+}
+
+
+/*
+  DROP applies to the objects defined earlier in the input, so that the result is the final state.
+  Indexes and views are not kept, except that DROP INDEX drops a PRIMARY KEY or UNIQUE in MySQL.
+*/
+func (c *converter) convertDrop() {
+	c.next() // skip "DROP"
+	kind := strings.ToUpper(c.next())
+	if kind == "INDEX" {
+		index, _ := c.convertIdentifier()
+		c.next() // skip "ON"
+		c.dropIndex(c.convertTableName(), index)
+		c.next() // skip ";"
+		return
+	}
+
+	names := []qualifiedName{c.convertTableName()}
+	for c.matchToken(",") {
+		c.next() // skip ","
+		names = append(names, c.convertTableName())
+	}
+	cascade := false
+	if c.matchToken("CASCADE", "RESTRICT") {
+		cascade = strings.ToUpper(c.next()) == "CASCADE"
+	}
+	c.next() // skip ";"
+
+	for _, name := range names {
+		switch (kind) {
+			case "TABLE":
+				c.dropTable(name, cascade)
+			case "SEQUENCE":
+				c.dropSequence(name, cascade)
+			case "SCHEMA", "DATABASE":
+				c.dropSchema(name.name, name.quoted)
+		}
+	}
+}
+
+
+/*
+  DROP TABLE also drops the sequences owned by the table (PostgreSQL).
+  With CASCADE, PostgreSQL drops the foreign keys that reference the table.
+  (CASCADE does nothing in MySQL.)
+*/
+func (c *converter) dropTable(name qualifiedName, cascade bool) {
+	i := c.resolveTable(name)
+	if i < 0 {
+		return
+	}
+	table := c.result.Tables[i]
+	c.result.Tables = append(c.result.Tables[:i], c.result.Tables[i+1:]...)
+
+	if c.rdbms != common.PostgreSQL {
+		return
+	}
+	sequences := []types.Sequence{}
+	for _, sequence := range c.result.Sequences {
+		owner := sequence.OwnedBy
+		if owner == nil || !c.isTable(table, owner.Schema, owner.SchemaQuoted, owner.TableName, owner.TableNameQuoted) {
+			sequences = append(sequences, sequence)
+		}
+	}
+	c.result.Sequences = sequences
+
+	if !cascade {
+		return
+	}
+	for i := range c.result.Tables {
+		t := &c.result.Tables[i]
+		for j := range t.Columns {
+			constraint := &t.Columns[j].Constraint
+			reference := constraint.References
+			if reference.TableName != "" && c.isTable(table, reference.Schema, reference.SchemaQuoted, reference.TableName, reference.TableNameQuoted) {
+				constraint.References = types.Reference{}
+				constraint.ReferencesName = ""
+			}
+		}
+		foreignKeys := []types.ForeignKey{}
+		for _, foreignKey := range t.Constraints.ForeignKey {
+			reference := foreignKey.References
+			if !c.isTable(table, reference.Schema, reference.SchemaQuoted, reference.TableName, reference.TableNameQuoted) {
+				foreignKeys = append(foreignKeys, foreignKey)
+			}
+		}
+		t.Constraints.ForeignKey = foreignKeys
+	}
+}
+
+
+// With CASCADE, the DEFAULT nextval() of the sequence are dropped.
+func (c *converter) dropSequence(name qualifiedName, cascade bool) {
+	name = c.qualify(name)
+	sequences := []types.Sequence{}
+	for _, s := range c.result.Sequences {
+		if !c.isTable(types.Table{Schema: s.Schema, SchemaQuoted: s.SchemaQuoted, Name: s.Name, NameQuoted: s.NameQuoted}, 
+			name.schema, name.schemaQuoted, name.name, name.quoted) {
+			sequences = append(sequences, s)
+		}
+	}
+	c.result.Sequences = sequences
+
+	if !cascade {
+		return
+	}
+	for i := range c.result.Tables {
+		for j := range c.result.Tables[i].Columns {
+			constraint := &c.result.Tables[i].Columns[j].Constraint
+			names := c.splitRegclassName(c.nextvalSequenceName(constraint.DefaultValue))
+			sequenceName := names[len(names) - 1]
+			if sequenceName != "" && c.equalTableName(sequenceName, true, name.name, name.quoted) {
+				constraint.Default = nil
+				constraint.DefaultValue = nil
+				constraint.DefaultName = ""
+			}
+		}
+	}
+}
+
+
+// The objects in the schema are dropped with it.
+func (c *converter) dropSchema(name string, quoted bool) {
+	schemas := []types.Schema{}
+	for _, schema := range c.result.Schemas {
+		if !c.equalTableName(schema.Name, schema.NameQuoted, name, quoted) {
+			schemas = append(schemas, schema)
+		}
+	}
+	c.result.Schemas = schemas
+
+	tables := []types.Table{}
+	for _, table := range c.result.Tables {
+		if !c.equalTableName(table.Schema, table.SchemaQuoted, name, quoted) {
+			tables = append(tables, table)
+		}
+	}
+	c.result.Tables = tables
+
+	sequences := []types.Sequence{}
+	for _, sequence := range c.result.Sequences {
+		if !c.equalTableName(sequence.Schema, sequence.SchemaQuoted, name, quoted) {
+			sequences = append(sequences, sequence)
+		}
+	}
+	c.result.Sequences = sequences
+
+	searchPath := []qualifiedName{}
+	for _, schema := range c.searchPath {
+		if !c.equalTableName(schema.schema, schema.schemaQuoted, name, quoted) {
+			searchPath = append(searchPath, schema)
+		}
+	}
+	c.searchPath = searchPath
+	if c.equalTableName(c.schema, c.schemaQuoted, name, quoted) {
+		c.schema, c.schemaQuoted = "", false
+		if len(searchPath) > 0 {
+			c.schema, c.schemaQuoted = searchPath[0].schema, searchPath[0].schemaQuoted
+		}
+	}
+}
+
+
+/*
+  MySQL: DROP INDEX index_name ON tbl_name.
+  The index of a column UNIQUE is named after the column, and the primary key is named PRIMARY.
+*/
+func (c *converter) dropIndex(name qualifiedName, index string) {
+	i := c.resolveTable(name)
+	if i < 0 {
+		return
+	}
+	table := &c.result.Tables[i]
+	if strings.EqualFold(index, "PRIMARY") {
+		table.Constraints.PrimaryKey = nil
+		for j := range table.Columns {
+			table.Columns[j].Constraint.IsPrimaryKey = false
+			table.Columns[j].Constraint.PrimaryKeyName = ""
+		}
+		return
+	}
+	uniques := []types.Unique{}
+	for _, unique := range table.Constraints.Unique {
+		if !strings.EqualFold(unique.Name, index) {
+			uniques = append(uniques, unique)
+		}
+	}
+	table.Constraints.Unique = uniques
+	for j := range table.Columns {
+		column := &table.Columns[j]
+		indexName := column.Constraint.UniqueName
+		if indexName == "" {
+			indexName = column.Name
+		}
+		if column.Constraint.IsUnique && strings.EqualFold(indexName, index) {
+			column.Constraint.IsUnique = false
+			column.Constraint.UniqueName = ""
+		}
+	}
+}
+
+
+// USE db_name;
+func (c *converter) convertUse() {
+	c.next() // skip "USE"
+	c.schema, c.schemaQuoted = c.convertIdentifier()
+	c.next() // skip ";"
+}
+
+
+/*
+  SET search_path TO {schema_name, ... | DEFAULT};
+  New tables are created in the first schema of the path,
+  and the other names are looked up in the schemas in order (lookupSearchPath).
+  "$user" is skipped, as the user is not known here.
+*/
+func (c *converter) convertSearchPath() {
+	c.next() // skip "SET"
+	c.next() // skip "SEARCH_PATH"
+	c.next() // skip "TO"
+	c.schema, c.schemaQuoted = "", false
+	c.searchPath = nil
+	for !c.isOutOfRange() && !c.matchToken(";") {
+		if c.matchToken(",") {
+			c.next() // skip ","
+			continue
+		}
+		if c.matchToken("DEFAULT") {
+			c.next() // skip "DEFAULT"
+			continue
+		}
+		name, quoted := "", false
+		if c.isStringValue(c.token()) {
+			name = c.convertStringValue()
+		} else {
+			name, quoted = c.convertIdentifier()
+		}
+		if name == "$user" {
+			continue
+		}
+		if len(c.searchPath) == 0 {
+			c.schema, c.schemaQuoted = name, quoted
+		}
+		c.searchPath = append(c.searchPath, qualifiedName{schema: name, schemaQuoted: quoted})
+	}
+	c.next() // skip ";"
+}
+
+
+/*
+  CREATE SCHEMA [IF NOT EXISTS] [schema_name] [AUTHORIZATION role];
+  CREATE {DATABASE | SCHEMA} [IF NOT EXISTS] db_name [CHARACTER SET charset] [COLLATE collation];
+  Without a name, the schema is named after the role.
+*/
+func (c *converter) convertSchema() types.Schema {
+	var schema types.Schema
+	c.next() // skip "CREATE"
+	schema.Database = strings.ToUpper(c.next()) == "DATABASE"
+
+	if c.matchToken("IF") {
+		c.next() // skip "IF"
+		c.next() // skip "NOT"
+		c.next() // skip "EXISTS"
+		schema.IfNotExists = true
+	}
+	if !c.matchToken("AUTHORIZATION") {
+		schema.Name, schema.NameQuoted = c.convertIdentifier()
+	}
+
+	for !c.isOutOfRange() && !c.matchToken(";") {
+		if c.matchToken("AUTHORIZATION") {
+			c.next() // skip "AUTHORIZATION"
+			name, quoted := c.convertIdentifier()
+			schema.Authorization = name
+			if schema.Name == "" {
+				schema.Name, schema.NameQuoted = name, quoted
+			}
+		} else if c.matchToken("CHARACTER") {
+			c.next() // skip "CHARACTER"
+			c.next() // skip "SET"
+			schema.Charset = c.convertName()
+		} else if c.matchToken("COLLATE") {
+			c.next() // skip "COLLATE"
+			schema.Collate = c.convertName()
+		} else {
+			c.next()
+		}
+	}
+	c.next() // skip ";"
+	return schema
+}
+
+
+// qualify gives an unqualified name the default schema.
+func (c *converter) qualify(name qualifiedName) qualifiedName {
+	if name.schema == "" {
+		name.schema, name.schemaQuoted = c.schema, c.schemaQuoted
+	}
+	return name
+}
+
+
+/*
+  An unqualified REFERENCES target is in the database of the referencing table in MySQL.
+  In PostgreSQL it is looked up in the schemas of the search_path in order,
+  among the tables defined so far and the referencing table itself.
+  SQLite has no default schema, so it is left empty.
+*/
+func (c *converter) qualifyReferences(table *types.Table) {
+	if c.rdbms == common.SQLite {
+		return
+	}
+	qualify := func(reference *types.Reference) {
+		if reference.TableName == "" || reference.Schema != "" {
+			return
+		}
+		if c.rdbms == common.MySQL {
+			reference.Schema, reference.SchemaQuoted = table.Schema, table.SchemaQuoted
+			return
+		}
+		name := c.lookupSearchPath(qualifiedName{name: reference.TableName, quoted: reference.TableNameQuoted}, table)
+		reference.Schema, reference.SchemaQuoted = name.schema, name.schemaQuoted
+	}
+	for i := range table.Columns {
+		qualify(&table.Columns[i].Constraint.References)
+	}
+	for i := range table.Constraints.ForeignKey {
+		qualify(&table.Constraints.ForeignKey[i].References)
+	}
+}
+
+
+// [schema_name.]name and whether each part was quoted.
+type qualifiedName struct {
+	schema string
+	schemaQuoted bool
+	name string
+	quoted bool
+}
+
+
+func (c *converter) convertTableName() qualifiedName {
+	var name qualifiedName
+	name.name, name.quoted = c.convertIdentifier()
+
+	if c.matchToken(".") {
+		c.next()
+		name.schema, name.schemaQuoted = name.name, name.quoted
+		name.name, name.quoted = c.convertIdentifier()
+	}
+
+	return name
+}
+
+
+// name[.name ...]
+func (c *converter) convertDottedNames() ([]string, []bool) {
+	name, quoted := c.convertIdentifier()
+	names, ls := []string{name}, []bool{quoted}
+	for c.matchToken(".") {
+		c.next() // skip "."
+		name, quoted = c.convertIdentifier()
+		names, ls = append(names, name), append(ls, quoted)
+	}
+	return names, ls
+}
+
+
+func (c *converter) convertName() string {
+	name, _ := c.convertIdentifier()
+	return name
+}
+
+
+// The name without quotes (doubled quotes are unescaped) and whether it was quoted.
+func (c *converter) convertIdentifier() (string, bool) {
+	token := c.next()
+	if c.isIdentifier(token) {
+		return c.unquoteString(token), true
+	}
+	return token, false
+}
+
+
+func (c *converter) equalTableName(name1 string, quoted1 bool, name2 string, quoted2 bool) bool {
+	return common.NormalizeTableName(c.rdbms, name1, quoted1) == common.NormalizeTableName(c.rdbms, name2, quoted2)
+}
+
+
+func (c *converter) equalColumnName(name1 string, quoted1 bool, name2 string, quoted2 bool) bool {
+	return common.NormalizeColumnName(c.rdbms, name1, quoted1) == common.NormalizeColumnName(c.rdbms, name2, quoted2)
+}
+
+
+// AS query, or [(column_name, ...)] [ON COMMIT ...] AS query
+func (c *converter) isCreateTableAs() bool {
+	depth := 0
+	for i := c.i; i < c.size; i++ {
+		if c.tokens[i] == "(" {
+			depth += 1
+		} else if c.tokens[i] == ")" {
+			depth -= 1
+		} else if depth == 0 && c.tokens[i] == ";" {
+			return false
+		} else if depth == 0 && strings.ToUpper(c.tokens[i]) == "AS" {
+			return true
+		}
+	}
+	return false
+}
+
+
+func (c *converter) convertCreateTableAs(table *types.Table) {
+	table.Columns = []types.Column{}
+	if c.matchToken("(") {
+		names, quoted := c.convertCommaSeparatedColumnNames()
+		for i, name := range names {
+			table.Columns = append(table.Columns, types.Column{Name: name, NameQuoted: c.isQuoted(quoted, i)})
+		}
+	}
+	if c.matchToken("ON") {
+		c.convertOnCommit(table)
+	}
+	c.next() // skip "AS"
+	table.Query = c.next()
+}
+
+
+// PostgreSQL: ON COMMIT {PRESERVE ROWS | DELETE ROWS | DROP}
+func (c *converter) convertOnCommit(table *types.Table) {
+	c.next() // skip "ON"
+	c.next() // skip "COMMIT"
+	table.OnCommit = strings.ToUpper(c.next())
+	if c.matchToken("ROWS") {
+		table.OnCommit += " " + strings.ToUpper(c.next())
+	}
+}
+
+
+// SQLite: USING module_name [(module-argument, ...)]
+func (c *converter) convertModule(table *types.Table) {
+	table.Columns = []types.Column{}
+	c.next() // skip "USING"
+	table.Module = c.next()
+	if !c.matchToken("(") {
+		return
+	}
+	c.next() // skip "("
+	for !c.isOutOfRange() && !c.matchToken(")") {
+		begin, depth := c.i, 0
+		for !c.isOutOfRange() && (depth > 0 || !c.matchToken(",", ")")) {
+			if c.matchToken("(") {
+				depth += 1
+			} else if c.matchToken(")") {
+				depth -= 1
+			}
+			c.i += 1
+		}
+		table.ModuleArguments = append(table.ModuleArguments, c.source(begin, c.i - 1))
+		if c.matchToken(",") {
+			c.next() // skip ","
+		}
+	}
+	c.next() // skip ")"
+}
+
+
+func (c *converter) convertTableDefinition(table *types.Table) {
+	table.Doc = c.convertDoc(table.Doc, c.comments(c.i))
+	c.next() // skip "("
+	table.Doc = c.convertDoc(table.Doc, c.trailingComments(c.i))
+	var columns []types.Column
+	var constraints types.TableConstraint
+	for !c.matchToken(")") {
+		begin := c.i
+		isColumn := false
+		if (c.matchToken("CONSTRAINT", "PRIMARY", "UNIQUE", "CHECK", "FOREIGN", "EXCLUDE")) {
+			c.convertTableConstraint(&constraints);
+		} else if c.matchToken("LIKE") {
+			c.convertLikeClause(&columns, &constraints)
+		} else {
+			column := c.convertColumnDefinition()
+			column.Doc = c.convertDoc(column.Doc, c.leadingComments(begin))
+			column.Doc = c.convertDoc(column.Doc, c.commentsBetween(begin, c.i - 1))
+			column.Doc = c.convertDoc(column.Doc, c.trailingComments(c.i))
+			columns = append(columns, column)
+			isColumn = true
+		}
+		
+		if c.matchToken(")") {
+			break
+		}
+		c.next()
+		if isColumn {
+			column := &columns[len(columns) - 1]
+			column.Doc = c.convertDoc(column.Doc, c.trailingComments(c.i))
+		}
+	}
+	c.next()
+	table.Columns = columns
+	table.Constraints = constraints
+}
+
+
+// Comments before the token at index i.
+func (c *converter) comments(i int) []string {
+	return common.Filter(c.trivia[i], common.IsCommentToken)
+}
+
+
+// Comments on the same line as the token before index i.
+func (c *converter) trailingComments(i int) []string {
+	return common.Filter(c.comments(i), common.IsTrailingComment)
+}
+
+
+// Comments on their own lines before the token at index i.
+func (c *converter) leadingComments(i int) []string {
+	return common.Filter(c.comments(i), func(token string) bool {
+		return !common.IsTrailingComment(token)
+	})
+}
+
+
+// Comments between the tokens at index begin and end.
+func (c *converter) commentsBetween(begin, end int) []string {
+	var comments []string
+	for i := begin + 1; i <= end; i++ {
+		comments = append(comments, c.comments(i)...)
+	}
+	return comments
+}
+
+
+func (c *converter) convertDoc(doc string, comments []string) string {
+	for _, comment := range comments {
+		text := common.CommentText(comment)
+		if text == "" {
+			continue
+		}
+		if doc != "" {
+			doc += "\n"
+		}
+		doc += text
+	}
+	return doc
+}
+
+
+var likeOptions = []string{
+	"COMMENTS", "COMPRESSION", "CONSTRAINTS", "DEFAULTS", "GENERATED",
+	"IDENTITY", "INDEXES", "STATISTICS", "STORAGE",
+}
+
+
+/*
+  Copy the columns and constraints of a table defined earlier in the input.
+  PostgreSQL copies the types, NOT NULL (including the one of PRIMARY KEY) and COLLATE only,
+  plus what the INCLUDING options ask for. SERIAL is copied as its integer type,
+  and with DEFAULTS its nextval() of the sequence of the source table.
+  MySQL copies everything. Foreign keys are never copied.
+*/
+func (c *converter) convertLikeClause(columns *[]types.Column, constraints *types.TableConstraint) {
+	c.next() // skip "LIKE"
+	name := c.qualify(c.convertTableName())
+
+	options := map[string]bool{}
+	for _, option := range likeOptions {
+		options[option] = c.rdbms == common.MySQL
+	}
+	for c.matchToken("INCLUDING", "EXCLUDING") {
+		including := strings.ToUpper(c.next()) == "INCLUDING"
+		option := strings.ToUpper(c.next())
+		if option == "ALL" {
+			for _, o := range likeOptions {
+				options[o] = including
+			}
+		} else {
+			options[option] = including
+		}
+	}
+
+	source := c.findTable(name)
+	if source == nil {
+		return
+	}
+	for _, column := range source.Columns {
+		*columns = append(*columns, c.likeColumn(source, column, options))
+	}
+	if options["INDEXES"] {
+		constraints.PrimaryKey = append(constraints.PrimaryKey, source.Constraints.PrimaryKey...)
+		constraints.Unique = append(constraints.Unique, source.Constraints.Unique...)
+		constraints.Exclude = append(constraints.Exclude, source.Constraints.Exclude...)
+	}
+	if options["CONSTRAINTS"] {
+		constraints.Check = append(constraints.Check, source.Constraints.Check...)
+	}
+}
+
+
+func (c *converter) likeColumn(source *types.Table, column types.Column, options map[string]bool) types.Column {
+	constraint := column.Constraint
+	if c.rdbms == common.PostgreSQL {
+		if c.isSerial(column.DataType.Name) {
+			column.DataType.Name = c.serialIntegerType(column.DataType.Name)
+			constraint.IsNotNull = true
+			// the default of the SERIAL column, linked to the sequence by linkSequences.
+			name, quoted := c.serialSequenceName(source, &column)
+			constraint.Default, constraint.DefaultValue = c.nextvalDefault(source.Schema, source.SchemaQuoted, name, quoted)
+			constraint.Sequence = ""
+			constraint.SequenceSchema = ""
+		}
+		if c.isPrimaryKeyColumn(source, column) {
+			constraint.IsNotNull = true
+		}
+	}
+	if !options["COMMENTS"] {
+		column.Comment = ""
+		column.Doc = ""
+	}
+	constraint.References = types.Reference{}
+	constraint.ReferencesName = ""
+	if !options["DEFAULTS"] {
+		constraint.Default = nil
+		constraint.DefaultValue = nil
+		constraint.DefaultName = ""
+		constraint.Sequence = ""
+		constraint.SequenceSchema = ""
+	}
+	if !options["IDENTITY"] {
+		constraint.Identity = nil
+	}
+	if !options["CONSTRAINTS"] {
+		constraint.Name = ""
+		constraint.Check = ""
+		constraint.CheckExpression = nil
+		constraint.CheckName = ""
+	}
+	if !options["GENERATED"] {
+		constraint.Generated = nil
+	}
+	if !options["INDEXES"] {
+		constraint.IsPrimaryKey = false
+		constraint.IsUnique = false
+		constraint.PrimaryKeyName = ""
+		constraint.UniqueName = ""
+		constraint.NullsNotDistinct = false
+		constraint.IndexParameters = nil
+	}
+	if constraint.IsAutoincrement {
+		if column.Constraint.Identity != nil {
+			constraint.IsAutoincrement = options["IDENTITY"]
+		} else {
+			constraint.IsAutoincrement = options["DEFAULTS"]
+		}
+	}
+	column.Constraint = constraint
+	return column
+}
+
+
+func (c *converter) isPrimaryKeyColumn(table *types.Table, column types.Column) bool {
+	if column.Constraint.IsPrimaryKey {
+		return true
+	}
+	for _, primaryKey := range table.Constraints.PrimaryKey {
+		for i, name := range primaryKey.ColumnNames {
+			if c.equalColumnName(column.Name, column.NameQuoted, name, c.isQuoted(primaryKey.ColumnNamesQuoted, i)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+
+func (c *converter) findTable(name qualifiedName) *types.Table {
+	if i := c.findTableIndex(name); i >= 0 {
+		return &c.result.Tables[i]
+	}
+	return nil
+}
+
+
+func (c *converter) findTableIndex(name qualifiedName) int {
+	for i := len(c.result.Tables) - 1; i >= 0; i-- {
+		table := &c.result.Tables[i]
+		if c.equalTableName(table.Schema, table.SchemaQuoted, name.schema, name.schemaQuoted) && 
+			c.equalTableName(table.Name, table.NameQuoted, name.name, name.quoted) {
+			return i
+		}
+	}
+	return -1
+}
+
+
+// resolveTable finds a table by a name in a statement (other than CREATE TABLE).
+// A table without a schema is also found as public.table_name (main.table_name in SQLite).
+func (c *converter) resolveTable(name qualifiedName) int {
+	name = c.lookupSearchPath(name, nil)
+	i := c.findTableIndex(name)
+	if i < 0 && c.isDefaultSchema(name.schema, name.schemaQuoted) {
+		i = c.findTableIndex(qualifiedName{name: name.name, quoted: name.quoted})
+	}
+	return i
+}
+
+
+/*
+  PostgreSQL: an unqualified name is qualified by the first schema of the search_path
+  where a table of the name is defined (defining is the table being defined, or nil).
+  If there is none, it is qualified by the first schema, as qualify does.
+*/
+func (c *converter) lookupSearchPath(name qualifiedName, defining *types.Table) qualifiedName {
+	if name.schema != "" {
+		return name
+	}
+	for _, schema := range c.searchPath {
+		if defining != nil && c.isTable(*defining, schema.schema, schema.schemaQuoted, name.name, name.quoted) {
+			return qualifiedName{schema: schema.schema, schemaQuoted: schema.schemaQuoted, name: name.name, quoted: name.quoted}
+		}
+		for _, table := range c.result.Tables {
+			if c.isTable(table, schema.schema, schema.schemaQuoted, name.name, name.quoted) {
+				return qualifiedName{schema: schema.schema, schemaQuoted: schema.schemaQuoted, name: name.name, quoted: name.quoted}
+			}
+		}
+	}
+	return c.qualify(name)
+}
+
+
+// isTable tells whether schema_name.table_name is the table.
+func (c *converter) isTable(table types.Table, schema string, schemaQuoted bool, name string, quoted bool) bool {
+	if !c.equalTableName(table.Name, table.NameQuoted, name, quoted) {
+		return false
+	}
+	if c.equalTableName(table.Schema, table.SchemaQuoted, schema, schemaQuoted) {
+		return true
+	}
+	return (table.Schema == "" && c.isDefaultSchema(schema, schemaQuoted)) ||
+		(schema == "" && c.isDefaultSchema(table.Schema, table.SchemaQuoted))
+}
+
+
+func (c *converter) isDefaultSchema(schema string, quoted bool) bool {
+	return common.IsDefaultSchema(c.rdbms, schema, quoted)
+}
+
+
+func (c *converter) convertColumnDefinition() types.Column {
+	var column types.Column
+	column.Name, column.NameQuoted = c.convertIdentifier()
+	if !c.isTypeOmitted() {
+		column.DataType = c.convertDateType()
+	}
+	c.convertConstraint(&column)
+	if c.isSerial(column.DataType.Name) {
+		column.Constraint.IsAutoincrement = true
+	}
+	
+	return column
+}
+
+
+// SQLite: the type may be omitted (CREATE TABLE sqlite_sequence(name,seq)).
+func (c *converter) isTypeOmitted() bool {
+	return c.rdbms == common.SQLite && c.matchToken(
+		",", ")", "CONSTRAINT", "PRIMARY", "NOT", "UNIQUE", "CHECK", "DEFAULT", 
+		"COLLATE", "REFERENCES", "GENERATED", "AS",
+	)
+}
+
+
+func (c *converter) isSerial(typeName string) bool {
+	switch (c.rdbms) {
+		case common.PostgreSQL:
+			return common.Contains([]string{
+				"SMALLSERIAL", "SERIAL2", "SERIAL", "SERIAL4", "BIGSERIAL", "SERIAL8",
+			}, typeName)
+		case common.MySQL:
+			return typeName == "SERIAL"
+	}
+	return false
+}
+
+
+// PostgreSQL: the integer type of SMALLSERIAL/SERIAL/BIGSERIAL.
+func (c *converter) serialIntegerType(typeName string) string {
+	switch (typeName) {
+		case "SMALLSERIAL", "SERIAL2":
+			return "SMALLINT"
+		case "BIGSERIAL", "SERIAL8":
+			return "BIGINT"
+	}
+	return "INTEGER"
+}
+
+
+/*
+  SERIAL column is expanded as follows.
+    id SERIAL
+      -> id INTEGER NOT NULL DEFAULT nextval('tablename_id_seq'::regclass)
+	     + CREATE SEQUENCE tablename_id_seq AS INTEGER OWNED BY tablename.id
+*/
+func (c *converter) expandSerial(table *types.Table) {
+	for i := range table.Columns {
+		column := &table.Columns[i]
+		if !c.isSerial(column.DataType.Name) {
+			continue
+		}
+		column.DataType.Name = c.serialIntegerType(column.DataType.Name)
+
+		var sequence types.Sequence
+		sequence.Schema, sequence.SchemaQuoted = table.Schema, table.SchemaQuoted
+		sequence.Name, sequence.NameQuoted = c.serialSequenceName(table, column)
+		sequence.DataType = column.DataType.Name
+		sequence.OwnedBy = &types.SequenceOwner{
+			Schema: table.Schema, 
+			TableName: table.Name, 
+			ColumnName: column.Name,
+			SchemaQuoted: table.SchemaQuoted,
+			TableNameQuoted: table.NameQuoted,
+			ColumnNameQuoted: column.NameQuoted,
+		}
+		c.result.Sequences = append(c.result.Sequences, sequence)
+
+		column.Constraint.IsNotNull = true
+		column.Constraint.Default, column.Constraint.DefaultValue = c.nextvalDefault(sequence.Schema, sequence.SchemaQuoted, sequence.Name, sequence.NameQuoted)
+	}
+}
+
+
+// PostgreSQL: DEFAULT nextval('[schema_name.]sequence_name'::regclass) of a SERIAL column.
+func (c *converter) nextvalDefault(schema string, schemaQuoted bool, name string, nameQuoted bool) (string, *types.DefaultValue) {
+	// quoted as pg_dump prints it: public."Users_Id_seq"
+	qualifiedName := c.quoteRegclassName(name, nameQuoted)
+	if schema != "" {
+		qualifiedName = c.quoteRegclassName(schema, schemaQuoted) + "." + qualifiedName
+	}
+	function := "nextval('" + strings.ReplaceAll(qualifiedName, "'", "''") + "'::regclass)"
+	return function, &types.DefaultValue{
+		Kind: types.DefaultFunction, 
+		Value: function,
+		Expression: &types.Expression{
+			Source: function,
+			Tree: &types.Expr{Kind: types.ExprFunction, Name: "nextval", Args: []*types.Expr{
+				{Kind: types.ExprCast, Type: "regclass", Operand: &types.Expr{Kind: types.ExprString, Value: qualifiedName}},
+			}},
+		},
+	}
+}
+
+
+// PostgreSQL: the sequence of a SERIAL column is named <table>_<column>_seq.
+func (c *converter) serialSequenceName(table *types.Table, column *types.Column) (string, bool) {
+	if table.NameQuoted || column.NameQuoted {
+		// the name made of the folded names is case-sensitive.
+		return common.NormalizeTableName(c.rdbms, table.Name, table.NameQuoted) + "_" + 
+			common.NormalizeColumnName(c.rdbms, column.Name, column.NameQuoted) + "_seq", true
+	}
+	return table.Name + "_" + column.Name + "_seq", false
+}
+
+
+// SQLite: the names beginning with "sqlite_" are reserved for the internal tables.
+func (c *converter) isInternalTable(name string) bool {
+	return strings.HasPrefix(strings.ToLower(name), "sqlite_")
+}
+
+
+/*
+  SQLite: the module of a virtual table keeps its data in the shadow tables
+  named <virtual table>_<suffix> (docs_data, docs_idx, ... of fts5 table docs).
+  They are flagged as Internal.
+*/
+func (c *converter) markShadowTables() {
+	for _, virtual := range c.result.Tables {
+		if !virtual.Virtual {
+			continue
+		}
+		prefix := common.NormalizeTableName(c.rdbms, virtual.Name, virtual.NameQuoted) + "_"
+		for i := range c.result.Tables {
+			table := &c.result.Tables[i]
+			// in the schema of the virtual table
+			if table.Virtual || !c.isTable(*table, virtual.Schema, virtual.SchemaQuoted, table.Name, table.NameQuoted) {
+				continue
+			}
+			if strings.HasPrefix(common.NormalizeTableName(c.rdbms, table.Name, table.NameQuoted), prefix) {
+				table.Internal = true
+			}
+		}
+	}
 }
 
 
-func (c *converter) convertTableName() (string, string) {
-	schemaName := c.convertName()
-	tableName := ""
-
-	if c.matchToken(".") {
-		c.next()
-		tableName = c.convertName()
-	} else {
-		tableName = schemaName
-		schemaName = ""
+/*
+  SQLite: a column is an alias for the rowid when the table has a rowid,
+  its declared type is exactly INTEGER and it is the only PRIMARY KEY column.
+  "INTEGER PRIMARY KEY DESC" in a column constraint is not an alias.
+*/
+func (c *converter) markRowidAlias(table *types.Table) {
+	if table.WithoutRowid {
+		return
+	}
+	name := ""
+	primaryKeys := 0
+	for _, column := range table.Columns {
+		if column.Constraint.IsPrimaryKey {
+			primaryKeys += 1
+			if column.Constraint.PrimaryKeyOrder != "DESC" {
+				name = column.Name
+			}
+		}
+	}
+	for _, primaryKey := range table.Constraints.PrimaryKey {
+		primaryKeys += 1
+		if len(primaryKey.ColumnNames) == 1 {
+			name = primaryKey.ColumnNames[0]
+		} else {
+			primaryKeys += 1
+		}
+	}
+	if primaryKeys != 1 || name == "" {
+		return
+	}
+	for i, column := range table.Columns {
+		dataType := column.DataType
+		if c.equalColumnName(column.Name, column.NameQuoted, name, false) && dataType.Name == "INTEGER" && dataType.DigitN == 0 && dataType.DigitM == 0 {
+			table.Columns[i].Constraint.IsRowidAlias = true
+		}
 	}
-
-	return schemaName, tableName 
 }
 
 
-func (c *converter) convertName() string {
-	token := c.next()
-	if c.isIdentifier(token) {
-		return token[1 : len(token)-1]
-	} else {
-		return token
+/*
+  Link the columns with DEFAULT nextval('[schema_name.]sequence_name') to the sequence.
+  PostgreSQL: a SERIAL column (not expanded by ExpandSerial) is linked to
+  the sequence created with it, <table>_<column>_seq in the schema of the table.
+*/
+func (c *converter) linkSequences() {
+	for i := range c.result.Tables {
+		table := &c.result.Tables[i]
+		for j := range table.Columns {
+			column := &table.Columns[j]
+			constraint := &column.Constraint
+			if c.rdbms == common.PostgreSQL && c.isSerial(column.DataType.Name) {
+				constraint.Sequence, _ = c.serialSequenceName(table, column)
+				constraint.SequenceSchema = table.Schema
+				continue
+			}
+			name := c.nextvalSequenceName(constraint.DefaultValue)
+			if name == "" {
+				continue
+			}
+			names := c.splitRegclassName(name)
+			constraint.IsAutoincrement = true
+			constraint.Sequence = names[len(names) - 1]
+			if len(names) > 1 {
+				constraint.SequenceSchema = names[len(names) - 2]
+			}
+		}
 	}
 }
 
 
-func (c *converter) convertTableDefinition() ([]types.Column, types.TableConstraint) {
-	c.next()
-	var columns []types.Column
-	var constraints types.TableConstraint
-	for !c.matchToken(")") {
-		if (c.matchToken("CONSTRAINT", "PRIMARY", "UNIQUE", "CHECK", "FOREIGN")) {
-			c.convertTableConstraint(&constraints);
-		} else {
-			column := c.convertColumnDefinition()
-			columns = append(columns, column)
+/*
+  The parts of a regclass name (schema_name."Sequence_Name"):
+  the quoted parts are unquoted, and the others are folded.
+*/
+func (c *converter) splitRegclassName(text string) []string {
+	parts := []string{}
+	part, quoted, inQuotes := "", false, false
+	runes := []rune(text)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if inQuotes {
+			if r == '"' && i + 1 < len(runes) && runes[i + 1] == '"' {
+				// "" is an escaped double quote.
+				part += "\""
+				i += 1
+			} else if r == '"' {
+				inQuotes = false
+			} else {
+				part += string(r)
+			}
+			continue
 		}
-		
-		if c.matchToken(")") {
-			break
+		if r == '"' {
+			inQuotes, quoted = true, true
+		} else if r == '.' {
+			parts = append(parts, common.NormalizeTableName(c.rdbms, part, quoted))
+			part, quoted = "", false
+		} else {
+			part += string(r)
 		}
-		c.next()
 	}
-	c.next()
-	return columns, constraints
+	return append(parts, common.NormalizeTableName(c.rdbms, part, quoted))
 }
 
 
-func (c *converter) convertColumnDefinition() types.Column {
-	var column types.Column
-	column.Name = c.convertName()
-	column.DataType = c.convertDateType()
-	column.Constraint = c.convertConstraint()
-	
-	return column
+// A part of a regclass name, in double quotes if it was quoted.
+func (c *converter) quoteRegclassName(name string, quoted bool) string {
+	if !quoted {
+		return name
+	}
+	return "\"" + strings.ReplaceAll(name, "\"", "\"\"") + "\""
+}
+
+
+// nextval('sequence_name') or nextval('sequence_name'::regclass)
+func (c *converter) nextvalSequenceName(defaultValue *types.DefaultValue) string {
+	if defaultValue == nil || defaultValue.Kind != types.DefaultFunction || 
+		defaultValue.Expression == nil || defaultValue.Expression.Tree == nil {
+		return ""
+	}
+	tree := defaultValue.Expression.Tree
+	if !strings.EqualFold(tree.Name, "nextval") || len(tree.Args) != 1 {
+		return ""
+	}
+	arg := tree.Args[0]
+	if arg.Kind == types.ExprCast && strings.EqualFold(arg.Type, "regclass") {
+		arg = arg.Operand
+	}
+	if arg.Kind != types.ExprString {
+		return ""
+	}
+	return arg.Value
 }
 
 
 func (c *converter) convertDateType() types.DataType {
 	var dataType types.DataType
+	if c.peek() == "." {
+		// PostgreSQL: a user-defined type is kept as written (public.mood).
+		dataType.Name = c.next() + c.next() + c.next()
+		c.convertArrayBounds(&dataType)
+		return dataType
+	}
 	dataType.Name = strings.ToUpper(c.next())
+	if c.rdbms == common.SQLite {
+		// SQLite: the type may be a sequence of names (UNSIGNED BIG INT).
+		for !c.isOutOfRange() && !c.matchToken("(") && !c.isTypeOmitted() {
+			dataType.Name += " " + strings.ToUpper(c.next())
+		}
+	}
 	if c.matchToken("VARYING") {
 		if dataType.Name == "BIT" {
 			dataType.Name = "VARBIT"
@@ -227,13 +1512,52 @@ func (c *converter) convertDateType() types.DataType {
 		}
 		c.next()
 	}
+	if c.rdbms == common.MySQL && (dataType.Name == "ENUM" || dataType.Name == "SET") {
+		c.next() // skip "("
+		dataType.Values = []string{c.convertStringValue()}
+		for c.matchToken(",") {
+			c.next() // skip ","
+			dataType.Values = append(dataType.Values, c.convertStringValue())
+		}
+		c.next() // skip ")"
+	}
 	n, m := c.convertTypeDigit()
 	dataType.DigitN = n
 	dataType.DigitM = m
+	c.convertTypeAttributes(&dataType)
+	c.convertArrayBounds(&dataType)
 	return dataType
 }
 
 
+func (c *converter) convertArrayBounds(dataType *types.DataType) {
+	for strings.HasPrefix(c.token(), "[") {
+		c.next()
+		dataType.Dimensions += 1
+	}
+}
+
+
+// MySQL: [UNSIGNED] [ZEROFILL] [CHARACTER SET charset_name]
+func (c *converter) convertTypeAttributes(dataType *types.DataType) {
+	if c.matchToken("UNSIGNED") {
+		c.next() // skip "UNSIGNED"
+		dataType.Unsigned = true
+	}
+	if c.matchToken("ZEROFILL") {
+		c.next() // skip "ZEROFILL"
+		// ZEROFILL implies UNSIGNED.
+		dataType.Unsigned = true
+		dataType.Zerofill = true
+	}
+	if c.matchToken("CHARACTER") {
+		c.next() // skip "CHARACTER"
+		c.next() // skip "SET"
+		dataType.Charset = c.convertName()
+	}
+}
+
+
 func (c *converter) convertTypeDigit() (int, int) {
 	n := 0
 	m := 0
@@ -250,108 +1574,413 @@ func (c *converter) convertTypeDigit() (int, int) {
 }
 
 
-func (c *converter) convertConstraint() types.Constraint {
-	var constraint types.Constraint
-	if c.matchToken("CONSTRAINT") {
-		c.next() // skip "CONSTRAINT"
-		if (!c.matchToken("PRIMARY", "UNIQUE", "NOT", "AUTOINCREMENT", "AUTO_INCREMENT", "DEFAULT", "CHECK", "REFERENCES", "COLLATE")) {
-			constraint.Name = c.convertName()
-		}
+func (c *converter) convertConstraint(column *types.Column) {
+	c.convertConstraintAux(column)
+}
+
+
+/*
+  CONSTRAINT name before a column constraint.
+  Name keeps the first name for compatibility.
+*/
+func (c *converter) convertConstraintName(constraint *types.Constraint) string {
+	if !c.matchToken("CONSTRAINT") {
+		return ""
 	}
-	c.convertConstraintAux(&constraint)
-	return constraint
+	c.next() // skip "CONSTRAINT"
+	if c.matchToken("PRIMARY", "UNIQUE", "NOT", "AUTOINCREMENT", "AUTO_INCREMENT", "DEFAULT", "CHECK", "REFERENCES", "COLLATE") {
+		return ""
+	}
+	name, quoted := c.convertIdentifier()
+	if constraint.Name == "" {
+		constraint.Name, constraint.NameQuoted = name, quoted
+	}
+	return name
 }
 
 
-func (c *converter) convertConstraintAux(constraint *types.Constraint) {
+func (c *converter) convertConstraintAux(column *types.Column) {
+	constraint := &column.Constraint
 	if c.matchToken(",", ")") {
 		return
 	}
+	name := c.convertConstraintName(constraint)
 	if c.matchToken("PRIMARY") {
 		c.next() // skip "PRIMARY"
 		c.next() // skip "KEY"
 		constraint.IsPrimaryKey = true
-		c.convertConstraintAux(constraint)
+		constraint.PrimaryKeyName = name
+		if c.matchToken("ASC", "DESC") {
+			constraint.PrimaryKeyOrder = strings.ToUpper(c.next())
+		}
+		constraint.PrimaryKeyOnConflict = c.convertConflictClause()
+		c.convertConstraintIndexParameters(constraint)
+		c.convertConstraintAux(column)
 		return 
 	}
 	if c.matchToken("AUTOINCREMENT", "AUTO_INCREMENT") {
 		c.next() // skip "AUTOINCREMENT"
 		constraint.IsAutoincrement = true
-		c.convertConstraintAux(constraint)
+		c.convertConstraintAux(column)
 		return
 	}
 	if c.matchToken("NOT") {
 		c.next() // skip "NOT"
 		c.next() // skip "NULL"
 		constraint.IsNotNull = true
-		c.convertConstraintAux(constraint)
+		constraint.NotNullName = name
+		constraint.NotNullOnConflict = c.convertConflictClause()
+		c.convertConstraintAux(column)
 		return
 	}
 	if c.matchToken("UNIQUE") {
 		c.next() // skip "UNIQUE"
 		constraint.IsUnique = true
-		c.convertConstraintAux(constraint)
+		constraint.UniqueName = name
+		constraint.NullsNotDistinct = c.convertNullsDistinct()
+		constraint.UniqueOnConflict = c.convertConflictClause()
+		c.convertConstraintIndexParameters(constraint)
+		c.convertConstraintAux(column)
 		return
 	}
 	if c.matchToken("DEFAULT") {
 		c.next() // skip "DEFAULT"
-		constraint.Default = c.convertDefaultValue()
-		c.convertConstraintAux(constraint)
+		constraint.DefaultName = name
+		c.convertDefaultValue(constraint)
+		c.convertConstraintAux(column)
+		return
+	}
+	if c.matchToken("ON") {
+		c.next() // skip "ON"
+		c.next() // skip "UPDATE"
+		constraint.OnUpdate = c.next()
+		if c.matchToken("(") {
+			constraint.OnUpdate += c.convertExpr()
+		}
+		c.convertConstraintAux(column)
 		return
 	}
 	if c.matchToken("CHECK") {
 		c.next() // skip "CHECK"
-		constraint.Check = c.convertExpr()
-		c.convertConstraintAux(constraint)
+		constraint.CheckName = name
+		constraint.Check, constraint.CheckExpression = c.convertBracketedExpr()
+		c.convertConstraintAux(column)
 		return
 	}
 	if c.matchToken("COLLATE") {
 		c.next() // skip "COLLATE"
 		constraint.Collate = c.convertName()
-		c.convertConstraintAux(constraint)
+		if c.matchToken(".") {
+			// PostgreSQL: pg_catalog."C" is "C".
+			c.next() // skip "."
+			constraint.Collate = c.convertName()
+		}
+		c.convertConstraintAux(column)
 		return
 	}
 	if c.matchToken("REFERENCES") {
+		constraint.ReferencesName = name
 		constraint.References = c.convertReference()
-		c.convertConstraintAux(constraint)
+		c.convertConstraintAux(column)
+		return
+	}
+	if c.matchToken("GENERATED") && c.i + 3 < c.size && c.tokens[c.i + 3] == "(" {
+		constraint.Generated = c.convertGenerated()
+		c.convertConstraintAux(column)
+		return
+	}
+	if c.matchToken("STORED", "VIRTUAL") {
+		stored := strings.ToUpper(c.next()) == "STORED"
+		if constraint.Generated != nil {
+			constraint.Generated.Stored = stored
+		}
+		c.convertConstraintAux(column)
+		return
+	}
+	if c.matchToken("GENERATED") {
+		constraint.Identity = c.convertIdentity()
+		constraint.IsAutoincrement = true
+		c.convertConstraintAux(column)
+		return
+	}
+	if c.matchToken("COMMENT") {
+		c.next() // skip "COMMENT"
+		column.Comment = c.convertStringValue()
+		c.convertConstraintAux(column)
+		return
+	}
+}
+
+
+func (c *converter) convertConstraintIndexParameters(constraint *types.Constraint) {
+	if !c.matchToken("INCLUDE", "WITH", "USING") {
 		return
 	}
+	indexParameters := c.convertIndexParameters()
+	constraint.IndexParameters = &indexParameters
+}
+
+
+// ON CONFLICT {ROLLBACK | ABORT | FAIL | IGNORE | REPLACE}
+func (c *converter) convertConflictClause() string {
+	if !c.matchToken("ON") {
+		return ""
+	}
+	c.next() // skip "ON"
+	c.next() // skip "CONFLICT"
+	return strings.ToUpper(c.next())
+}
+
+
+// GENERATED ALWAYS AS (expr)
+func (c *converter) convertGenerated() *types.Generated {
+	var generated types.Generated
+	c.next() // skip "GENERATED"
+	c.next() // skip "ALWAYS"
+	c.next() // skip "AS"
+	_, expression := c.convertBracketedExpr()
+	generated.Expression = *expression
+	return &generated
+}
+
+
+func (c *converter) convertIdentity() *types.Identity {
+	var identity types.Identity
+	c.next() // skip "GENERATED"
+	if c.matchToken("ALWAYS") {
+		c.next() // skip "ALWAYS"
+		identity.Always = true
+	} else if c.matchToken("BY") {
+		c.next() // skip "BY"
+		c.next() // skip "DEFAULT"
+	}
+	c.next() // skip "AS"
+	c.next() // skip "IDENTITY"
+	if c.matchToken("(") {
+		c.next() // skip "("
+		identity.SequenceOptions = c.convertSequenceOptions()
+		c.next() // skip ")"
+	}
+	return &identity
+}
+
+
+func (c *converter) convertSequenceOptions() types.SequenceOptions {
+	var options types.SequenceOptions
+	for {
+		if c.matchToken("AS") {
+			c.next() // skip "AS"
+			options.DataType = strings.ToUpper(c.next())
+		} else if c.matchToken("INCREMENT") {
+			c.next() // skip "INCREMENT"
+			options.Increment = c.convertInteger()
+		} else if c.matchToken("START") {
+			c.next() // skip "START"
+			options.Start = c.convertInteger()
+		} else if c.matchToken("MINVALUE") {
+			c.next() // skip "MINVALUE"
+			options.MinValue = c.convertInteger()
+		} else if c.matchToken("MAXVALUE") {
+			c.next() // skip "MAXVALUE"
+			options.MaxValue = c.convertInteger()
+		} else if c.matchToken("CACHE") {
+			c.next() // skip "CACHE"
+			options.Cache = c.convertInteger()
+		} else if c.matchToken("CYCLE") {
+			c.next() // skip "CYCLE"
+			options.Cycle = true
+		} else if c.matchToken("NO") {
+			c.next() // skip "NO"
+			c.next() // skip "MINVALUE" or "MAXVALUE" or "CYCLE"
+		} else {
+			break
+		}
+	}
+	return options
+}
+
+
+func (c *converter) convertInteger() *int64 {
+	n, _ := strconv.ParseInt(c.next(), 10, 64)
+	return &n
 }
 
 
-func (c *converter) convertDefaultValue() interface{} {
+func (c *converter) convertDefaultValue(constraint *types.Constraint) {
 	if c.matchToken("(") {
-		return c.convertExpr()
+		expr, expression := c.convertBracketedExpr()
+		constraint.Default = expr
+		constraint.DefaultValue = &types.DefaultValue{
+			Kind: types.DefaultExpression, Value: expr, Expression: expression,
+		}
+	} else if c.peek() == "(" || c.peek() == "." {
+		begin := c.i
+		if c.peek() == "." {
+			c.next() // skip schema name
+			c.next() // skip "."
+		}
+		c.next() // skip function name
+		end := c.skipBrackets()
+		function := c.source(begin, end)
+		constraint.Default = function
+		constraint.DefaultValue = &types.DefaultValue{
+			Kind: types.DefaultFunction, Value: function, Expression: c.convertExpression(begin, end),
+		}
 	} else {
-		return c.convertLiteralValue()
+		begin := c.i
+		constraint.DefaultValue = c.convertLiteralDefaultValue(c.token())
+		constraint.Default = c.convertLiteralValue()
+		if c.matchToken("::") {
+			// PostgreSQL: 'active'::character varying
+			c.skipCastTypes()
+			constraint.DefaultValue.Expression = c.convertExpression(begin, c.i - 1)
+		}
+	}
+}
+
+
+func (c *converter) skipCastTypes() {
+	for c.matchToken("::") {
+		c.next() // skip "::"
+		c.next() // skip type name
+		if c.matchToken(".") {
+			c.next() // skip "."
+			c.next() // skip type name
+		}
+		for c.matchToken(typeNameWords...) {
+			c.next()
+		}
+		if c.matchToken("(") {
+			c.skipBrackets()
+		}
+		for strings.HasPrefix(c.token(), "[") {
+			c.next()
+		}
+	}
+}
+
+
+func (c *converter) convertLiteralDefaultValue(token string) *types.DefaultValue {
+	if common.IsNumericToken(token) {
+		return &types.DefaultValue{Kind: types.DefaultNumber, Value: token}
 	}
+	if c.isStringValue(token) {
+		return &types.DefaultValue{Kind: types.DefaultString, Value: c.unquoteString(token)}
+	}
+	switch strings.ToUpper(token) {
+		case "NULL":
+			return &types.DefaultValue{Kind: types.DefaultNull}
+		case "TRUE", "FALSE":
+			return &types.DefaultValue{Kind: types.DefaultBoolean, Value: strings.ToLower(token)}
+	}
+	return &types.DefaultValue{Kind: types.DefaultKeyword, Value: strings.ToUpper(token)}
 }
 
 
+// (expr) as written.
 func (c *converter) convertExpr() string {
-	return c.next() + c.convertExprAux() + c.next()
+	begin := c.i
+	end := c.skipBrackets()
+	return c.source(begin, end)
 }
 
 
-func (c *converter) convertExprAux() string {
-	if c.matchToken(")") {
-		return ""
+// (expr) as written, and the expression in the brackets.
+func (c *converter) convertBracketedExpr() (string, *types.Expression) {
+	begin := c.i
+	end := c.skipBrackets()
+	return c.source(begin, end), c.convertExpression(begin + 1, end - 1)
+}
+
+
+// The expression of the tokens from index begin to end.
+// Tree is left nil if the parser does not support the expression.
+func (c *converter) convertExpression(begin, end int) *types.Expression {
+	expression := &types.Expression{Source: c.source(begin, end)}
+	tree, err := c.newExprParser(c.tokens[begin : end + 1]).parse()
+	if err == nil {
+		expression.Tree = tree
 	}
-	if c.matchToken("(") {
-		return c.convertExpr() + c.convertExprAux()
+	return expression
+}
+
+
+// Skip the tokens from "(" to the matching ")" and return the index of ")".
+func (c *converter) skipBrackets() int {
+	depth := 0
+	for i := c.i; i < c.size; i++ {
+		if c.tokens[i] == "(" {
+			depth += 1
+		} else if c.tokens[i] == ")" {
+			depth -= 1
+		}
+		if depth == 0 {
+			c.i = i
+			c.next() // skip ")"
+			return i
+		}
+	}
+	c.i = c.size
+	return c.size - 1
+}
+
+
+// The tokens from index begin to end as written.
+func (c *converter) source(begin, end int) string {
+	text := ""
+	for i := begin; i <= end; i++ {
+		if i > begin {
+			text += c.triviaText(i)
+		}
+		text += c.tokens[i]
+	}
+	return text
+}
+
+
+/*
+  The trivia before the token at index i as written.
+  The lexer appends a "\n" token for each line break in a comment (after the comment)
+  and in a string (before the string), so that they are not written twice.
+*/
+func (c *converter) triviaText(i int) string {
+	trivia := c.trivia[i]
+	n := strings.Count(c.tokens[i], "\n")
+	for j := len(trivia) - 1; j >= 0 && n > 0; j-- {
+		if trivia[j] == "\n" {
+			trivia = append(append([]string{}, trivia[:j]...), trivia[j + 1:]...)
+			n -= 1
+		}
+	}
+	text := ""
+	skip := 0
+	for _, token := range trivia {
+		if token == "\n" && skip > 0 {
+			skip -= 1
+			continue
+		}
+		if common.IsCommentToken(token) {
+			token = strings.TrimPrefix(token, " ")
+			skip = strings.Count(token, "\n")
+		}
+		text += token
 	}
-	return c.next() + c.convertExprAux()
+	return text
 }
 
 
 func (c *converter) convertLiteralValue() interface{} {
 	token := c.next()
 	if common.IsNumericToken(token) {
-		n, _ := strconv.ParseFloat(token, 64)
+		n, err := strconv.ParseFloat(token, 64)
+		if err != nil {
+			// out of the range of float64 (1e400): kept as written.
+			return token
+		}
 		return n
 	}
 	if c.isStringValue(token) {
-		return token[1 : len(token)-1]
+		return c.unquoteString(token)
 	}
 	if strings.ToUpper(token) == "NULL" {
 		return nil
@@ -366,23 +1995,41 @@ func (c *converter) convertLiteralValue() interface{} {
 }
 
 
+func (c *converter) convertStringValue() string {
+	return c.unquoteString(c.next())
+}
+
+
+// unquoteString strips the quotes and unescapes doubled quote characters.
+func (c *converter) unquoteString(token string) string {
+	q := token[0:1]
+	if q == "[" {
+		// SQLite: [name]
+		return token[1 : len(token)-1]
+	}
+	return strings.ReplaceAll(token[1 : len(token)-1], q + q, q)
+}
+
+
 func (c *converter) convertReference() types.Reference {
 	var reference types.Reference
 	c.next() // skip "REFERENCES"
-	reference.TableName = c.convertName()
+	name := c.convertTableName()
+	reference.Schema, reference.SchemaQuoted = name.schema, name.schemaQuoted
+	reference.TableName, reference.TableNameQuoted = name.name, name.quoted
 	if c.matchToken("(") {
-		reference.ColumnNames = c.convertCommaSeparatedColumnNames()
+		reference.ColumnNames, reference.ColumnNamesQuoted = c.convertCommaSeparatedColumnNames()
 	}
 	return reference
 }
 
 
 func (c *converter) convertTableConstraint(tableConstraint *types.TableConstraint) {
-	name := ""
+	name, quoted := "", false
 	if c.matchToken("CONSTRAINT") {
 		c.next() // skip "CONSTRAINT"
-		if !c.matchToken("PRIMARY", "UNIQUE", "CHECK", "FOREIGN") {
-			name = c.convertName()
+		if !c.matchToken("PRIMARY", "UNIQUE", "CHECK", "FOREIGN", "EXCLUDE") {
+			name, quoted = c.convertIdentifier()
 		}
 	}
 
@@ -390,40 +2037,148 @@ func (c *converter) convertTableConstraint(tableConstraint *types.TableConstrain
 		var primaryKey types.PrimaryKey
 		c.next() // skip "PRIMARY"
 		c.next() // skip "KEY"
-		primaryKey.Name = name
-		primaryKey.ColumnNames = c.convertCommaSeparatedColumnNames()
+		primaryKey.Name, primaryKey.NameQuoted = name, quoted
+		primaryKey.ColumnNames, primaryKey.ColumnNamesQuoted, primaryKey.ColumnOrders = c.convertIndexedColumnNames()
+		primaryKey.OnConflict = c.convertConflictClause()
+		primaryKey.IndexParameters = c.convertIndexParameters()
 		tableConstraint.PrimaryKey = append(tableConstraint.PrimaryKey, primaryKey)
 
 	} else if c.matchToken("UNIQUE") {
 		var unique types.Unique
 		c.next() // skip "UNIQUE"
-		unique.Name = name
-		unique.ColumnNames = c.convertCommaSeparatedColumnNames()
+		unique.Name, unique.NameQuoted = name, quoted
+		if !c.matchToken("(", "NULLS") {
+			// MySQL: UNIQUE [INDEX | KEY] index_name (...)
+			unique.Name, unique.NameQuoted = c.convertIdentifier()
+		}
+		unique.NullsNotDistinct = c.convertNullsDistinct()
+		unique.ColumnNames, unique.ColumnNamesQuoted = c.convertCommaSeparatedColumnNames()
+		unique.OnConflict = c.convertConflictClause()
+		unique.IndexParameters = c.convertIndexParameters()
 		tableConstraint.Unique = append(tableConstraint.Unique, unique)
 
 	} else if c.matchToken("CHECK") {
 		var check types.Check
 		c.next() // skip "CHECK"
-		check.Name = name
-		check.Expr = c.convertExpr()
+		check.Name, check.NameQuoted = name, quoted
+		check.Expr, check.Expression = c.convertBracketedExpr()
 		tableConstraint.Check = append(tableConstraint.Check, check)
 
 	} else if c.matchToken("FOREIGN") {
 		var foreignKey types.ForeignKey
 		c.next() // skip "FOREIGN"
 		c.next() // skip "KEY"
-		foreignKey.Name = name
-		foreignKey.ColumnNames = c.convertCommaSeparatedColumnNames()
+		foreignKey.Name, foreignKey.NameQuoted = name, quoted
+		foreignKey.ColumnNames, foreignKey.ColumnNamesQuoted = c.convertCommaSeparatedColumnNames()
 		foreignKey.References = c.convertReference()
 		tableConstraint.ForeignKey = append(tableConstraint.ForeignKey, foreignKey)
+
+	} else if c.matchToken("EXCLUDE") {
+		var exclude types.Exclude
+		c.next() // skip "EXCLUDE"
+		exclude.Name, exclude.NameQuoted = name, quoted
+		if c.matchToken("USING") {
+			c.next() // skip "USING"
+			exclude.IndexMethod = c.convertName()
+		}
+		exclude.Elements = c.convertExcludeElements()
+		exclude.IndexParameters = c.convertIndexParameters()
+		if c.matchToken("WHERE") {
+			c.next() // skip "WHERE"
+			exclude.Where = c.convertExpr()
+		}
+		tableConstraint.Exclude = append(tableConstraint.Exclude, exclude)
 	}
 	return
 }
 
 
-func (c *converter) convertCommaSeparatedColumnNames() []string {
+func (c *converter) convertExcludeElements() []types.ExcludeElement {
+	c.next() // skip "("
+	ls := []types.ExcludeElement{}
+	for !c.matchToken(")") {
+		var element types.ExcludeElement
+		if c.matchToken("(") {
+			element.Element = c.convertExpr()
+		} else {
+			element.Element = c.convertName()
+		}
+		c.next() // skip "WITH"
+		element.Operator = c.next()
+		ls = append(ls, element)
+		if c.matchToken(",") {
+			c.next()
+		}
+	}
+	c.next() // skip ")"
+	return ls
+}
+
+
+func (c *converter) convertNullsDistinct() bool {
+	if !c.matchToken("NULLS") {
+		return false
+	}
+	c.next() // skip "NULLS"
+	notDistinct := false
+	if c.matchToken("NOT") {
+		c.next() // skip "NOT"
+		notDistinct = true
+	}
+	c.next() // skip "DISTINCT"
+	return notDistinct
+}
+
+
+func (c *converter) convertIndexParameters() types.IndexParameters {
+	var indexParameters types.IndexParameters
+	if c.matchToken("INCLUDE") {
+		c.next() // skip "INCLUDE"
+		indexParameters.Include, _ = c.convertCommaSeparatedColumnNames()
+	}
+	if c.matchToken("WITH") {
+		c.next() // skip "WITH"
+		indexParameters.StorageParameters = c.convertStorageParameters()
+	}
+	if c.matchToken("USING") {
+		c.next() // skip "USING"
+		c.next() // skip "INDEX"
+		c.next() // skip "TABLESPACE"
+		indexParameters.Tablespace = c.convertName()
+	}
+	return indexParameters
+}
+
+
+// fillfactor=70 may arrive as one token or as "fillfactor" "=" "70".
+func (c *converter) convertStorageParameters() map[string]string {
+	c.next() // skip "("
+	params := map[string]string{}
+	for !c.matchToken(")") {
+		param := ""
+		for !c.matchToken(",", ")") {
+			param += c.next()
+		}
+		key, value, _ := strings.Cut(param, "=")
+		params[strings.ToLower(key)] = value
+		if c.matchToken(",") {
+			c.next() // skip ","
+		}
+	}
+	c.next() // skip ")"
+	return params
+}
+
+
+/*
+  (column_name, ...)
+  Also return whether each name was quoted (nil if none of them was quoted).
+*/
+func (c *converter) convertCommaSeparatedColumnNames() ([]string, []bool) {
 	c.next() // skip "(""
 	ls := []string{}
+	quoted := []bool{}
+	isQuoted := false
 	for {
 		if c.matchToken(")") {
 			break
@@ -431,9 +2186,58 @@ func (c *converter) convertCommaSeparatedColumnNames() []string {
 			c.next()
 			continue
 		}
-		ls = append(ls, c.token())
-		c.next()
+		name, q := c.convertIdentifier()
+		ls = append(ls, name)
+		quoted = append(quoted, q)
+		isQuoted = isQuoted || q
 	}
 	c.next()
-	return ls
-}
\ No newline at end of file
+	if !isQuoted {
+		return ls, nil
+	}
+	return ls, quoted
+}
+
+
+/*
+  SQLite: (column_name [ASC | DESC], ...)
+  The orders are nil when no column has ASC/DESC ("" for the column without it).
+*/
+func (c *converter) convertIndexedColumnNames() ([]string, []bool, []string) {
+	c.next() // skip "("
+	ls := []string{}
+	quoted := []bool{}
+	orders := []string{}
+	isQuoted, isOrdered := false, false
+	for {
+		if c.matchToken(")") {
+			break
+		} else if c.matchToken(",") {
+			c.next()
+			continue
+		}
+		name, q := c.convertIdentifier()
+		order := ""
+		if c.matchToken("ASC", "DESC") {
+			order = strings.ToUpper(c.next())
+		}
+		ls = append(ls, name)
+		quoted = append(quoted, q)
+		orders = append(orders, order)
+		isQuoted = isQuoted || q
+		isOrdered = isOrdered || order != ""
+	}
+	c.next()
+	if !isQuoted {
+		quoted = nil
+	}
+	if !isOrdered {
+		orders = nil
+	}
+	return ls, quoted, orders
+}
+
+
+func (c *converter) isQuoted(quoted []bool, i int) bool {
+	return i < len(quoted) && quoted[i]
+}
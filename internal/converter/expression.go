@@ -0,0 +1,746 @@
+package converter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kodaimura/ddlparse/internal/types"
+	"github.com/kodaimura/ddlparse/internal/common"
+)
+
+
+/*
+////////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////////
+
+  parse():
+    Parse the tokens of an expression (CHECK, DEFAULT, GENERATED ALWAYS AS ...)
+	into an expression tree.
+	Operators and their precedence follow each RDBMS.
+	Return an error if the expression is not supported (e.g. subqueries).
+
+////////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////////
+*/
+
+type exprParser struct {
+	c *converter
+	rdbms common.Rdbms
+	tokens []string
+	size int
+	i int
+}
+
+
+// precedence, from the lowest
+const (
+	precLowest = iota
+	precOr
+	precXor
+	precAnd
+	precNot
+	precIs
+	precComparison
+	precPattern
+	precOther
+	precBitOr
+	precBitAnd
+	precShift
+	precAdditive
+	precMultiplicative
+	precExponent
+	precUnary
+	precCollate
+	precCast
+)
+
+
+var patternOperators = []string{"LIKE", "ILIKE", "GLOB", "REGEXP", "RLIKE", "MATCH", "SIMILAR"}
+
+var exprKeywords = []string{
+	"CURRENT_TIMESTAMP", "CURRENT_DATE", "CURRENT_TIME", "LOCALTIME", "LOCALTIMESTAMP",
+	"CURRENT_USER", "SESSION_USER", "CURRENT_ROLE", "CURRENT_CATALOG", "CURRENT_SCHEMA",
+}
+
+// words that can not be a column name or a function name in an expression.
+var exprReserved = []string{
+	"AND", "OR", "XOR", "NOT", "IS", "IN", "BETWEEN", "ESCAPE", "CASE", "WHEN", "THEN",
+	"ELSE", "END", "AS", "DISTINCT", "FROM", "SELECT", "EXISTS", "DIV", "MOD", "COLLATE",
+	"LIKE", "ILIKE", "GLOB", "REGEXP", "RLIKE", "MATCH", "SIMILAR", "ISNULL", "NOTNULL",
+}
+
+// continuation of multi-word type names: double precision, timestamp with time zone, ...
+var typeNameWords = []string{"PRECISION", "VARYING", "WITH", "WITHOUT", "TIME", "ZONE"}
+
+
+func (c *converter) newExprParser(tokens []string) *exprParser {
+	p := &exprParser{c: c, rdbms: c.rdbms}
+	p.init(tokens)
+	return p
+}
+
+
+func (p *exprParser) init(tokens []string) {
+	p.tokens = []string{}
+	for _, token := range tokens {
+		// the lexer keeps the sign with the number: "a -1" is "a", "-" and "1".
+		if len(p.tokens) > 0 && p.isNumber(token) &&
+			(token[0:1] == "-" || token[0:1] == "+") && p.endsOperand(p.tokens[len(p.tokens) - 1]) {
+			p.tokens = append(p.tokens, token[0:1], token[1:])
+			continue
+		}
+		p.tokens = append(p.tokens, token)
+	}
+	p.size = len(p.tokens)
+	p.i = 0
+}
+
+
+func (p *exprParser) parse() (*types.Expr, error) {
+	expr, err := p.parseExpr(precLowest)
+	if err != nil {
+		return nil, err
+	}
+	if !p.isOutOfRange() {
+		return nil, p.parseError()
+	}
+	return expr, nil
+}
+
+
+func (p *exprParser) token() string {
+	if p.isOutOfRange() {
+		return common.EOF
+	}
+	return p.tokens[p.i]
+}
+
+
+func (p *exprParser) peek() string {
+	if p.i + 1 > p.size - 1 {
+		return common.EOF
+	}
+	return p.tokens[p.i + 1]
+}
+
+
+func (p *exprParser) isOutOfRange() bool {
+	return p.i > p.size - 1
+}
+
+
+func (p *exprParser) next() string {
+	token := p.token()
+	p.i += 1
+	return token
+}
+
+
+func (p *exprParser) matchToken(keywords ...string) bool {
+	return common.Contains(keywords, strings.ToUpper(p.token()))
+}
+
+
+func (p *exprParser) expectToken(keywords ...string) error {
+	if !p.matchToken(keywords...) {
+		return p.parseError()
+	}
+	p.next()
+	return nil
+}
+
+
+func (p *exprParser) parseError() error {
+	return fmt.Errorf("unsupported expression near '%s'", p.token())
+}
+
+
+func (p *exprParser) isNumber(token string) bool {
+	return common.IsNumericToken(token) && strings.ContainsAny(token, "0123456789")
+}
+
+
+func (p *exprParser) isOperator(token string) bool {
+	return token != "" && strings.Trim(token, "+-*/<>=~!@%^&|:#") == ""
+}
+
+
+func (p *exprParser) endsOperand(token string) bool {
+	if p.isOperator(token) || token == "(" || token == "," {
+		return false
+	}
+	return !common.Contains(exprReserved, strings.ToUpper(token))
+}
+
+
+/*
+  Precedence of the infix (or postfix) operator at the current token.
+  Return precLowest if the token is not an operator.
+*/
+func (p *exprParser) precedence() int {
+	op := strings.ToUpper(p.token())
+	if p.isOutOfRange() || op == ")" || op == "," {
+		return precLowest
+	}
+	if op == "NOT" {
+		op = strings.ToUpper(p.peek())
+		if op == "NULL" && p.rdbms == common.SQLite {
+			return precIs
+		}
+		if op != "IN" && op != "BETWEEN" && !common.Contains(patternOperators, op) {
+			return precLowest
+		}
+	}
+	switch (p.rdbms) {
+		case common.SQLite:
+			return p.precedenceSQLite(op)
+		case common.MySQL:
+			return p.precedenceMySQL(op)
+		case common.PostgreSQL:
+			return p.precedencePostgreSQL(op)
+	}
+	return precLowest
+}
+
+
+func (p *exprParser) precedenceSQLite(op string) int {
+	switch (op) {
+		case "OR":
+			return precOr
+		case "AND":
+			return precAnd
+		case "=", "==", "!=", "<>", "IS", "IN", "BETWEEN", "ISNULL", "NOTNULL",
+			"LIKE", "GLOB", "MATCH", "REGEXP":
+			return precIs
+		case "<", "<=", ">", ">=":
+			return precComparison
+		case "&", "|", "<<", ">>":
+			return precBitOr
+		case "+", "-":
+			return precAdditive
+		case "*", "/", "%":
+			return precMultiplicative
+		case "||", "->", "->>":
+			return precExponent
+		case "COLLATE":
+			return precCollate
+	}
+	return precLowest
+}
+
+
+func (p *exprParser) precedenceMySQL(op string) int {
+	switch (op) {
+		case "OR", "||":
+			return precOr
+		case "XOR":
+			return precXor
+		case "AND", "&&":
+			return precAnd
+		case "BETWEEN":
+			return precIs
+		case "=", "<=>", ">=", ">", "<=", "<", "<>", "!=", "IS", "IN",
+			"LIKE", "REGEXP", "RLIKE":
+			return precComparison
+		case "|":
+			return precBitOr
+		case "&":
+			return precBitAnd
+		case "<<", ">>":
+			return precShift
+		case "+", "-":
+			return precAdditive
+		case "*", "/", "%", "DIV", "MOD":
+			return precMultiplicative
+		case "^":
+			return precExponent
+		case "->", "->>":
+			return precUnary
+		case "COLLATE":
+			return precCollate
+	}
+	return precLowest
+}
+
+
+func (p *exprParser) precedencePostgreSQL(op string) int {
+	switch (op) {
+		case "OR":
+			return precOr
+		case "AND":
+			return precAnd
+		case "IS", "ISNULL", "NOTNULL":
+			return precIs
+		case "=", "<>", "!=", "<", ">", "<=", ">=":
+			return precComparison
+		case "IN", "BETWEEN", "LIKE", "ILIKE", "SIMILAR":
+			return precPattern
+		case "+", "-":
+			return precAdditive
+		case "*", "/", "%":
+			return precMultiplicative
+		case "^":
+			return precExponent
+		case "COLLATE":
+			return precCollate
+		case "::":
+			return precCast
+	}
+	if p.isOperator(op) {
+		return precOther
+	}
+	return precLowest
+}
+
+
+func (p *exprParser) parseExpr(min int) (*types.Expr, error) {
+	left, err := p.parsePrefix()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		prec := p.precedence()
+		if prec == precLowest || prec < min {
+			return left, nil
+		}
+		if left, err = p.parseInfix(left, prec); err != nil {
+			return nil, err
+		}
+	}
+}
+
+
+func (p *exprParser) parsePrefix() (*types.Expr, error) {
+	token := p.token()
+	if p.isOutOfRange() {
+		return nil, p.parseError()
+	}
+	if strings.ToUpper(token) == "NOT" {
+		p.next() // skip "NOT"
+		operand, err := p.parseExpr(precNot)
+		if err != nil {
+			return nil, err
+		}
+		return &types.Expr{Kind: types.ExprUnary, Op: "NOT", Operand: operand}, nil
+	}
+	if token == "-" || token == "+" || token == "~" || token == "!" {
+		p.next() // skip operator
+		operand, err := p.parseExpr(precUnary)
+		if err != nil {
+			return nil, err
+		}
+		return &types.Expr{Kind: types.ExprUnary, Op: token, Operand: operand}, nil
+	}
+	if token == "(" {
+		p.next() // skip "("
+		if p.matchToken("SELECT", "WITH", "VALUES") {
+			return nil, p.parseError()
+		}
+		expr, err := p.parseExpr(precLowest)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectToken(")"); err != nil {
+			return nil, err
+		}
+		return expr, nil
+	}
+	return p.parsePrimary()
+}
+
+
+func (p *exprParser) parsePrimary() (*types.Expr, error) {
+	token := p.token()
+	upper := strings.ToUpper(token)
+	if p.isNumber(token) {
+		p.next()
+		return &types.Expr{Kind: types.ExprNumber, Value: token}, nil
+	}
+	if p.c.isStringValue(token) {
+		p.next()
+		return &types.Expr{Kind: types.ExprString, Value: p.c.unquoteString(token)}, nil
+	}
+	if token == "*" {
+		p.next()
+		return &types.Expr{Kind: types.ExprStar}, nil
+	}
+	if p.isOperator(token) || token == ")" || token == "," || common.Contains(exprReserved, upper) {
+		if upper == "CASE" {
+			return p.parseCase()
+		}
+		return nil, p.parseError()
+	}
+	switch (upper) {
+		case "NULL":
+			p.next()
+			return &types.Expr{Kind: types.ExprNull}, nil
+		case "TRUE", "FALSE":
+			p.next()
+			return &types.Expr{Kind: types.ExprBoolean, Value: strings.ToLower(token)}, nil
+		case "CAST":
+			if p.peek() == "(" {
+				return p.parseCast()
+			}
+	}
+	if common.Contains(exprKeywords, upper) && p.peek() != "(" {
+		p.next()
+		return &types.Expr{Kind: types.ExprKeyword, Value: upper}, nil
+	}
+	if next := p.peek(); next != common.EOF && p.c.isStringValue(next) && !p.c.isIdentifier(token) {
+		return p.parseTypedString()
+	}
+	return p.parseName()
+}
+
+
+// column reference or function call: [schema.][table.]name, name(args)
+func (p *exprParser) parseName() (*types.Expr, error) {
+	names := []string{p.unquote(p.next())}
+	for p.token() == "." {
+		p.next() // skip "."
+		if p.isOutOfRange() {
+			return nil, p.parseError()
+		}
+		names = append(names, p.unquote(p.next()))
+	}
+	if p.token() == "(" {
+		return p.parseFunction(strings.Join(names, "."))
+	}
+	last := len(names) - 1
+	return &types.Expr{
+		Kind: types.ExprColumn,
+		Table: strings.Join(names[:last], "."),
+		Name: names[last],
+	}, nil
+}
+
+
+func (p *exprParser) unquote(token string) string {
+	if p.c.isIdentifier(token) {
+		return p.c.unquoteString(token)
+	}
+	return token
+}
+
+
+func (p *exprParser) parseFunction(name string) (*types.Expr, error) {
+	p.next() // skip "("
+	if p.matchToken("DISTINCT", "ALL", "SELECT") {
+		return nil, p.parseError()
+	}
+	args, err := p.parseExprList()
+	if err != nil {
+		return nil, err
+	}
+	return &types.Expr{Kind: types.ExprFunction, Name: name, Args: args}, nil
+}
+
+
+// expr, expr, ... ")"
+func (p *exprParser) parseExprList() ([]*types.Expr, error) {
+	args := []*types.Expr{}
+	if p.token() == ")" {
+		p.next() // skip ")"
+		return args, nil
+	}
+	for {
+		arg, err := p.parseExpr(precLowest)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		if p.token() == ")" {
+			p.next() // skip ")"
+			return args, nil
+		}
+		if err := p.expectToken(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+
+// CASE [operand] WHEN expr THEN expr ... [ELSE expr] END
+func (p *exprParser) parseCase() (*types.Expr, error) {
+	var err error
+	expr := &types.Expr{Kind: types.ExprCase}
+	p.next() // skip "CASE"
+	if !p.matchToken("WHEN") {
+		if expr.Operand, err = p.parseExpr(precLowest); err != nil {
+			return nil, err
+		}
+	}
+	for p.matchToken("WHEN") {
+		var when types.CaseWhen
+		p.next() // skip "WHEN"
+		if when.When, err = p.parseExpr(precLowest); err != nil {
+			return nil, err
+		}
+		if err := p.expectToken("THEN"); err != nil {
+			return nil, err
+		}
+		if when.Then, err = p.parseExpr(precLowest); err != nil {
+			return nil, err
+		}
+		expr.Whens = append(expr.Whens, when)
+	}
+	if len(expr.Whens) == 0 {
+		return nil, p.parseError()
+	}
+	if p.matchToken("ELSE") {
+		p.next() // skip "ELSE"
+		if expr.Else, err = p.parseExpr(precLowest); err != nil {
+			return nil, err
+		}
+	}
+	if err := p.expectToken("END"); err != nil {
+		return nil, err
+	}
+	return expr, nil
+}
+
+
+// CAST(expr AS type)
+func (p *exprParser) parseCast() (*types.Expr, error) {
+	p.next() // skip "CAST"
+	p.next() // skip "("
+	operand, err := p.parseExpr(precLowest)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectToken("AS"); err != nil {
+		return nil, err
+	}
+	begin, depth := p.i, 0
+	for !p.isOutOfRange() && !(depth == 0 && p.token() == ")") {
+		if p.token() == "(" {
+			depth += 1
+		} else if p.token() == ")" {
+			depth -= 1
+		}
+		p.next()
+	}
+	if begin == p.i {
+		return nil, p.parseError()
+	}
+	typeName := p.joinTypeName(p.tokens[begin:p.i])
+	if err := p.expectToken(")"); err != nil {
+		return nil, err
+	}
+	return &types.Expr{Kind: types.ExprCast, Operand: operand, Type: typeName}, nil
+}
+
+
+/*
+  type 'string' (PostgreSQL: DATE '2000-01-01', INTERVAL '1 day').
+  MySQL: _charset 'string' is a string.
+*/
+func (p *exprParser) parseTypedString() (*types.Expr, error) {
+	typeName := p.next()
+	value := &types.Expr{Kind: types.ExprString, Value: p.c.unquoteString(p.next())}
+	if p.rdbms == common.MySQL && strings.HasPrefix(typeName, "_") {
+		return value, nil
+	}
+	return &types.Expr{Kind: types.ExprCast, Operand: value, Type: strings.ToUpper(typeName)}, nil
+}
+
+
+// type name after "::": name [words] [(n [, m])] [[]]
+func (p *exprParser) parseTypeName() (string, error) {
+	if p.isOutOfRange() || p.isOperator(p.token()) || p.token() == "(" || p.token() == ")" {
+		return "", p.parseError()
+	}
+	begin := p.i
+	p.next()
+	for p.token() == "." || p.peekBehind() == "." {
+		p.next()
+	}
+	for p.matchToken(typeNameWords...) {
+		p.next()
+	}
+	if p.token() == "(" {
+		for !p.isOutOfRange() && p.token() != ")" {
+			p.next()
+		}
+		if err := p.expectToken(")"); err != nil {
+			return "", err
+		}
+	}
+	for strings.HasPrefix(p.token(), "[") {
+		p.next()
+	}
+	return p.joinTypeName(p.tokens[begin:p.i]), nil
+}
+
+
+func (p *exprParser) peekBehind() string {
+	if p.i < 1 || p.isOutOfRange() {
+		return common.EOF
+	}
+	return p.tokens[p.i - 1]
+}
+
+
+// joinTypeName joins the tokens of a type name: "numeric(10,2)", "timestamp with time zone".
+func (p *exprParser) joinTypeName(tokens []string) string {
+	name := ""
+	prev := ""
+	for i, token := range tokens {
+		if i > 0 && prev != "(" && prev != "." && prev != "," && token != "(" &&
+			token != ")" && token != "," && token != "." && !strings.HasPrefix(token, "[") {
+			name += " "
+		}
+		name += token
+		prev = token
+	}
+	return name
+}
+
+
+func (p *exprParser) parseInfix(left *types.Expr, prec int) (*types.Expr, error) {
+	not := false
+	if p.matchToken("NOT") {
+		p.next() // skip "NOT"
+		not = true
+	}
+	op := strings.ToUpper(p.token())
+	switch {
+		case op == "NULL" || op == "ISNULL" || op == "NOTNULL":
+			// x NOT NULL, x ISNULL, x NOTNULL
+			p.next()
+			return &types.Expr{
+				Kind: types.ExprBinary,
+				Op: "IS",
+				Not: not || op == "NOTNULL",
+				Left: left,
+				Right: &types.Expr{Kind: types.ExprNull},
+			}, nil
+		case op == "IS":
+			return p.parseIs(left, prec)
+		case op == "IN":
+			return p.parseIn(left, not)
+		case op == "BETWEEN":
+			return p.parseBetween(left, prec, not)
+		case common.Contains(patternOperators, op):
+			return p.parseLike(left, prec, not)
+		case op == "COLLATE":
+			p.next() // skip "COLLATE"
+			if p.isOutOfRange() {
+				return nil, p.parseError()
+			}
+			return &types.Expr{Kind: types.ExprCollate, Operand: left, Name: p.unquote(p.next())}, nil
+		case op == "::":
+			p.next() // skip "::"
+			typeName, err := p.parseTypeName()
+			if err != nil {
+				return nil, err
+			}
+			return &types.Expr{Kind: types.ExprCast, Operand: left, Type: typeName}, nil
+	}
+	if !p.isOperator(op) {
+		op = strings.ToUpper(op)
+	} else {
+		op = p.token()
+	}
+	p.next() // skip operator
+	right, err := p.parseExpr(prec + 1)
+	if err != nil {
+		return nil, err
+	}
+	return &types.Expr{Kind: types.ExprBinary, Op: op, Left: left, Right: right}, nil
+}
+
+
+// IS [NOT] {NULL | TRUE | FALSE | UNKNOWN | DISTINCT FROM expr | expr}
+func (p *exprParser) parseIs(left *types.Expr, prec int) (*types.Expr, error) {
+	expr := &types.Expr{Kind: types.ExprBinary, Op: "IS", Left: left}
+	p.next() // skip "IS"
+	if p.matchToken("NOT") {
+		p.next() // skip "NOT"
+		expr.Not = true
+	}
+	if p.matchToken("DISTINCT") {
+		p.next() // skip "DISTINCT"
+		if err := p.expectToken("FROM"); err != nil {
+			return nil, err
+		}
+		expr.Op = "IS DISTINCT FROM"
+	}
+	if p.matchToken("UNKNOWN") {
+		p.next() // skip "UNKNOWN"
+		expr.Right = &types.Expr{Kind: types.ExprKeyword, Value: "UNKNOWN"}
+		return expr, nil
+	}
+	right, err := p.parseExpr(prec + 1)
+	if err != nil {
+		return nil, err
+	}
+	expr.Right = right
+	return expr, nil
+}
+
+
+// [NOT] IN (expr, ...)
+func (p *exprParser) parseIn(left *types.Expr, not bool) (*types.Expr, error) {
+	p.next() // skip "IN"
+	if err := p.expectToken("("); err != nil {
+		return nil, err
+	}
+	if p.matchToken("SELECT", "WITH", "VALUES") {
+		return nil, p.parseError()
+	}
+	args, err := p.parseExprList()
+	if err != nil {
+		return nil, err
+	}
+	return &types.Expr{Kind: types.ExprIn, Not: not, Operand: left, Args: args}, nil
+}
+
+
+// [NOT] BETWEEN [SYMMETRIC] low AND high
+func (p *exprParser) parseBetween(left *types.Expr, prec int, not bool) (*types.Expr, error) {
+	p.next() // skip "BETWEEN"
+	op := "BETWEEN"
+	if p.rdbms == common.PostgreSQL && p.matchToken("SYMMETRIC", "ASYMMETRIC") {
+		op += " " + strings.ToUpper(p.next())
+	}
+	low, err := p.parseExpr(prec + 1)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectToken("AND"); err != nil {
+		return nil, err
+	}
+	high, err := p.parseExpr(prec + 1)
+	if err != nil {
+		return nil, err
+	}
+	if op == "BETWEEN" {
+		op = ""
+	}
+	return &types.Expr{Kind: types.ExprBetween, Op: op, Not: not, Operand: left, Args: []*types.Expr{low, high}}, nil
+}
+
+
+// [NOT] {LIKE | ILIKE | GLOB | REGEXP | RLIKE | MATCH | SIMILAR TO} pattern [ESCAPE escape]
+func (p *exprParser) parseLike(left *types.Expr, prec int, not bool) (*types.Expr, error) {
+	var err error
+	op := strings.ToUpper(p.next())
+	if op == "SIMILAR" {
+		if err := p.expectToken("TO"); err != nil {
+			return nil, err
+		}
+		op = "SIMILAR TO"
+	}
+	expr := &types.Expr{Kind: types.ExprLike, Op: op, Not: not, Left: left}
+	if expr.Right, err = p.parseExpr(prec + 1); err != nil {
+		return nil, err
+	}
+	if p.matchToken("ESCAPE") {
+		p.next() // skip "ESCAPE"
+		if expr.Escape, err = p.parseExpr(prec + 1); err != nil {
+			return nil, err
+		}
+	}
+	return expr, nil
+}
@@ -1,6 +1,9 @@
 package lexer
 
 import (
+	"strings"
+	"strconv"
+
 	"github.com/kodaimura/ddlparse/internal/common"
 )
 
@@ -15,7 +18,9 @@ type Lexer interface {
 
   Lex(): 
     Transform ddl (string) to tokens([]string). 
-	And Remove sql comments.
+	And Remove sql comments (or keep them as tokens with Options.KeepComments).
+	The lexer created by NewLexerWithOptions also keeps spaces as tokens,
+	so that the source text of expressions can be taken as written.
 	Return an ValidateError 
 	 if the closing part of a multiline comment or string is not found.
 
@@ -46,11 +51,15 @@ Example:
 
 type lexer struct {
 	rdbms common.Rdbms
+	options common.Options
+	keepSpaces bool
 	ddlr []rune
 	size int
 	i int
 	line int
 	result []string
+	// the number of open versioned comments (/*!...) in MySQLDump.
+	versioned int
 }
 
 
@@ -59,6 +68,11 @@ func NewLexer(rdbms common.Rdbms) Lexer {
 }
 
 
+func NewLexerWithOptions(rdbms common.Rdbms, options common.Options) Lexer {
+	return &lexer{rdbms: rdbms, options: options, keepSpaces: true}
+}
+
+
 func (l *lexer) Lex(ddl string) ([]string, error) {
 	l.init(ddl)
 	if err := l.lex(); err != nil {
@@ -74,6 +88,7 @@ func (l *lexer) init(ddl string) {
 	l.i = 0
 	l.line = 1
 	l.result = []string{}
+	l.versioned = 0
 }
 
 
@@ -87,6 +102,44 @@ func (l *lexer) next() string {
 }
 
 
+func (l *lexer) peek() string {
+	if l.i + 1 > l.size - 1 {
+		return common.EOF
+	}
+	return string(l.ddlr[l.i + 1])
+}
+
+
+func (l *lexer) isDigit(c string) bool {
+	return c >= "0" && c <= "9" && len(c) == 1
+}
+
+
+// The integer part of a number (any number of digits).
+func (l *lexer) isDigits(token string) bool {
+	return token != "" && strings.Trim(token, "0123456789") == ""
+}
+
+
+// A number followed by "e" ("1e", "-1.5E"), which an exponent follows.
+func (l *lexer) isMantissa(token string) bool {
+	token = strings.TrimLeft(token, "+-")
+	if !strings.HasSuffix(token, "e") && !strings.HasSuffix(token, "E") {
+		return false
+	}
+	parts := strings.Split(token[:len(token) - 1], ".")
+	if len(parts) > 2 || strings.Join(parts, "") == "" {
+		return false
+	}
+	for _, part := range parts {
+		if part != "" && !l.isDigits(part) {
+			return false
+		}
+	}
+	return true
+}
+
+
 func (l *lexer) char() string {
 	if l.isOutOfRange() {
 		return common.EOF
@@ -118,9 +171,7 @@ func (l *lexer) lex() error {
 		c := l.char()
 
 		if c == "-" {
-			if err := l.lexHyphen(&token); err != nil {
-				return err
-			}
+			l.lexHyphen(&token)
 		} else if c == "/" {
 			if err := l.lexSlash(&token); err != nil {
 				return err
@@ -148,6 +199,17 @@ func (l *lexer) lex() error {
 			if err := l.lexBackQuote(&token); err != nil {
 				return err
 			}
+		} else if c == "[" && l.rdbms == common.SQLite {
+			if err := l.lexBracketQuote(&token); err != nil {
+				return err
+			}
+		} else if c == "$" && token == "" && l.rdbms == common.PostgreSQL {
+			if err := l.lexDollarQuote(&token); err != nil {
+				return err
+			}
+		} else if c == "\\" && l.isPgDump() && l.isLineStart() {
+			l.skipMetaCommand()
+
 		} else if c == "#" {
 			l.lexSharp(&token)
 
@@ -159,6 +221,17 @@ func (l *lexer) lex() error {
 
 		} else if c == "(" || c == ")" || c == "," || c == "." || c == ";" {
 			l.lexSymbol(&token)
+			if c == ";" && l.isPgDump() && l.isCopyFromStdin() {
+				if err := l.skipCopyData(); err != nil {
+					return err
+				}
+			}
+
+		} else if l.isOperator(c) {
+			l.lexOperator(&token)
+
+		} else if c == "[" && l.rdbms == common.PostgreSQL && l.isArrayBounds() {
+			l.lexArrayBounds(&token)
 
 		} else if c == "　" {
 			return l.lexError()
@@ -169,60 +242,196 @@ func (l *lexer) lex() error {
 		}
 	}
 	l.appendToken(token)
+	if l.versioned > 0 {
+		return l.lexError()
+	}
 	return nil
 }
 
 
-func (l *lexer) lexHyphen(token *string) error {
-	c := l.char()
-	if c == "-" {
+func (l *lexer) isMySQLDump() bool {
+	return l.rdbms == common.MySQL && l.options.MySQLDump
+}
+
+
+func (l *lexer) mysqlVersion() int {
+	if l.options.MySQLVersion == 0 {
+		return common.DefaultMySQLVersion
+	}
+	return l.options.MySQLVersion
+}
+
+
+// MySQLDump: a versioned comment ("/*!version ...", or "/*! ...") is run by MySQL
+// if the version is not greater than the version of the server.
+// The markers are dropped and the content is lexed as usual.
+// Otherwise it is a comment.
+func (l *lexer) enterVersionedComment() bool {
+	if !l.isMySQLDump() || l.i + 2 > l.size - 1 || string(l.ddlr[l.i + 2]) != "!" {
+		return false
+	}
+	j := l.i + 3
+	for j < l.size && l.isDigit(string(l.ddlr[j])) {
+		j += 1
+	}
+	if j > l.i + 3 {
+		version, _ := strconv.Atoi(string(l.ddlr[l.i + 3 : j]))
+		if version > l.mysqlVersion() {
+			return false
+		}
+	}
+	l.i = j
+	l.versioned += 1
+	return true
+}
+
+
+func (l *lexer) isPgDump() bool {
+	return l.rdbms == common.PostgreSQL && l.options.PgDump
+}
+
+
+func (l *lexer) isLineStart() bool {
+	return l.i == 0 || string(l.ddlr[l.i - 1]) == "\n"
+}
+
+
+// PgDump: a psql meta-command (\restrict, \connect, ...) takes the rest of the line.
+func (l *lexer) skipMetaCommand() {
+	for !l.isOutOfRange() && l.char() != "\n" {
 		l.next()
-		if l.char() == "-" {
-			l.appendToken(*token)
-			*token = ""
-			l.skipComment()
-		} else {
-			*token += c
+	}
+}
+
+
+/*
+  PgDump: the data of COPY ... FROM stdin follows the statement
+  and ends with a line "\.".
+*/
+func (l *lexer) isCopyFromStdin() bool {
+	tokens := []string{}
+	for i := len(l.result) - 1; i >= 0; i-- {
+		if common.IsTriviaToken(l.result[i]) {
+			continue
 		}
+		if l.result[i] == ";" && len(tokens) > 0 {
+			break
+		}
+		tokens = append(tokens, strings.ToUpper(l.result[i]))
 	}
+	n := len(tokens)
+	return n >= 4 && tokens[n - 1] == "COPY" && tokens[2] == "FROM" && tokens[1] == "STDIN"
+}
 
-	return nil
+
+func (l *lexer) skipCopyData() error {
+	for !l.isOutOfRange() && l.char() != "\n" {
+		l.next()
+	}
+	for !l.isOutOfRange() {
+		l.next() // skip "\n"
+		l.line += 1
+		l.appendToken("\n")
+		begin := l.i
+		for !l.isOutOfRange() && l.char() != "\n" {
+			l.next()
+		}
+		if strings.TrimRight(string(l.ddlr[begin:l.i]), "\r") == "\\." {
+			return nil
+		}
+	}
+	return l.lexError()
+}
+
+
+func (l *lexer) lexHyphen(token *string) {
+	if l.char() == "-" && l.peek() == "-" {
+		l.appendToken(*token)
+		*token = ""
+		l.next()
+		l.skipComment()
+	}
+	return
 }
 
 
 func (l *lexer) lexSlash(token *string) error {
-	c := l.char()
-	if c == "/" {
+	if l.char() == "/" && l.peek() == "*" {
+		l.appendToken(*token)
+		*token = ""
+		if l.enterVersionedComment() {
+			return nil
+		}
+		at, begin := len(l.result), l.i
 		l.next()
-		if l.char() == "*" {
-			l.appendToken(*token)
-			*token = ""
-			if err := l.skipMultiLineComment(); err != nil {
-				return err
-			}
-		} else {
-			*token += c
+		if err := l.skipMultiLineComment(); err != nil {
+			return err
 		}
+		// keep the comment before the "\n" tokens appended while skipping it.
+		l.insertComment(at, string(l.ddlr[begin:l.i]))
 	}
 	return nil
 }
 
 
 func (l *lexer) lexAsterisk(token *string) error {
-	c := l.char()
-	if c == "*" {
-		l.next()
-		if l.char() == "/" {
-			l.i -= 1
+	if l.char() == "*" && l.peek() == "/" {
+		if l.versioned == 0 {
 			return l.lexError()
-		} else {
-			*token += c
 		}
-	} 
+		// the end of a versioned comment
+		l.appendToken(*token)
+		*token = ""
+		l.next()
+		l.next()
+		l.versioned -= 1
+	}
 	return nil
 }
 
 
+func (l *lexer) isOperator(c string) bool {
+	return len(c) == 1 && strings.Contains("+-*/<>=~!@%^&|:", c)
+}
+
+
+func (l *lexer) lexOperator(token *string) {
+	c := l.char()
+	// sign of a number: "-1", "+1"
+	if *token == "" && (c == "-" || c == "+") && l.isDigit(l.peek()) {
+		*token += c
+		l.next()
+		return
+	}
+	// sign of an exponent: "1.5e-10"
+	if l.isMantissa(*token) && (c == "-" || c == "+") && l.isDigit(l.peek()) {
+		*token += c
+		l.next()
+		return
+	}
+	l.appendToken(*token)
+	*token = ""
+	op := ""
+	for l.isOperator(l.char()) {
+		if (l.char() == "-" && l.peek() == "-") || (l.char() == "/" && l.peek() == "*") {
+			break
+		}
+		if l.versioned > 0 && l.char() == "*" && l.peek() == "/" {
+			break
+		}
+		op += l.next()
+	}
+	// a multi-character operator does not end in "+" or "-" ("=-1" is "=" and "-1"),
+	// unless it contains one of the characters below (as PostgreSQL does).
+	for len(op) > 1 && strings.ContainsAny(op[len(op) - 1:], "+-") && !strings.ContainsAny(op, "~!@%^&|") {
+		op = op[:len(op) - 1]
+		l.i -= 1
+	}
+	l.appendToken(op)
+	return
+}
+
+
 func (l *lexer) lexDoubleQuote(token *string) error {
 	c := l.char()
 	if c == "\"" {
@@ -271,6 +480,77 @@ func (l *lexer) lexBackQuote(token *string) error {
 }
 
 
+// SQLite: [name] is an identifier (as in MS Access and SQL Server).
+func (l *lexer) lexBracketQuote(token *string) error {
+	l.appendToken(*token)
+	*token = ""
+	str, err := l.lexStringBracketQuote()
+	if err != nil {
+		return err
+	}
+	l.appendToken(str)
+	return nil
+}
+
+
+// PostgreSQL: $$...$$ or $tag$...$tag$ is a string (e.g. the body of a function).
+func (l *lexer) lexDollarQuote(token *string) error {
+	j := l.i + 1
+	for j < l.size && l.isTagChar(string(l.ddlr[j]), j == l.i + 1) {
+		j += 1
+	}
+	if j >= l.size || string(l.ddlr[j]) != "$" {
+		*token += l.next()
+		return nil
+	}
+	tag := l.ddlr[l.i : j + 1]
+	str := string(tag)
+	l.i = j + 1
+	for !l.isOutOfRange() {
+		if l.char() == "$" && l.i + len(tag) <= l.size && string(l.ddlr[l.i : l.i + len(tag)]) == string(tag) {
+			l.i += len(tag)
+			l.appendToken(str + string(tag))
+			return nil
+		}
+		if l.char() == "\n" {
+			l.line += 1
+			l.appendToken("\n")
+		}
+		str += l.next()
+	}
+	return l.lexError()
+}
+
+
+func (l *lexer) isTagChar(c string, first bool) bool {
+	if c == "_" || (c >= "a" && c <= "z") || (c >= "A" && c <= "Z") {
+		return true
+	}
+	return !first && l.isDigit(c)
+}
+
+
+// PostgreSQL: "[]" or "[n]" of an array type (text[], integer[3][])
+func (l *lexer) isArrayBounds() bool {
+	j := l.i + 1
+	for j < l.size && l.isDigit(string(l.ddlr[j])) {
+		j += 1
+	}
+	return j < l.size && string(l.ddlr[j]) == "]"
+}
+
+
+func (l *lexer) lexArrayBounds(token *string) {
+	l.appendToken(*token)
+	*token = ""
+	bounds := ""
+	for l.char() != "]" {
+		bounds += l.next()
+	}
+	l.appendToken(bounds + l.next())
+}
+
+
 func (l *lexer) lexSharp(token *string) {
 	c := l.char()
 	if c == "#" {
@@ -301,18 +581,27 @@ func (l *lexer) lexEOL(token *string) {
 
 
 func (l *lexer) lexSpace(token *string) {
-	c := l.char()
-	if c == " " || c == "\t" {
-		l.appendToken(*token)
-		*token = ""
+	l.appendToken(*token)
+	*token = ""
+	space := ""
+	for l.char() == " " || l.char() == "\t" {
+		space += l.next()
+	}
+	if l.keepSpaces {
+		l.appendToken(space)
 	}
-	l.next()
 	return
 }
 
 
 func (l *lexer) lexSymbol(token *string) {
 	c := l.char()
+	if c == "." && l.isDigits(strings.TrimLeft(*token, "+-")) && l.isDigit(l.peek()) {
+		// decimal point of a number
+		*token += c
+		l.next()
+		return
+	}
 	if c == "(" || c == ")" || c == "," || c == "." || c == ";" {
 		l.appendToken(*token)
 		l.appendToken(c)
@@ -325,19 +614,31 @@ func (l *lexer) lexSymbol(token *string) {
 
 func (l *lexer) skipComment() {
 	l.next()
+	begin := l.i
 	for !l.isOutOfRange() {
 		if l.char() == "\n" {
-			l.line += 1
-			l.appendToken("\n")
 			break
 		}
 		l.next()
 	}
+	l.insertComment(len(l.result), "--" + string(l.ddlr[begin:l.i]))
+	if !l.isOutOfRange() {
+		l.line += 1
+		l.appendToken("\n")
+	}
 	l.next()
 	return
 }
 
 
+func (l *lexer) insertComment(at int, comment string) {
+	if !l.options.KeepComments {
+		return
+	}
+	l.result = append(l.result[:at], append([]string{comment}, l.result[at:]...)...)
+}
+
+
 func (l *lexer) skipMultiLineComment() error {
 	l.next()
 	c := ""
@@ -373,21 +674,25 @@ func (l *lexer) lexStringDoubleQuote() (string, error) {
 		if c == "\n" {
 			l.line += 1
 			l.appendToken("\n")
+			str += c
 		} else if c == "\"" {
 			l.next()
-			return str + c, nil
-		} else if c == "'" {
-			s, err := l.lexStringSingleQuote()
-			str += s
-			if err != nil {
-				return str, err
+			if l.char() != "\"" {
+				return str + c, nil
 			}
-		} else if c == "`" {
-			s, err := l.lexStringBackQuote()
-			str += s
-			if err != nil {
-				return str, err
+			// "" is an escaped double quote.
+			str += c + c
+		} else if c == "\\" && l.isMySQLDump() {
+			// MySQL: a backslash escapes the next character.
+			l.next()
+			if l.isOutOfRange() {
+				break
 			}
+			if l.char() == "\n" {
+				l.line += 1
+				l.appendToken("\n")
+			}
+			str += c + l.char()
 		} else {
 			str += c
 		}
@@ -406,21 +711,25 @@ func (l *lexer) lexStringSingleQuote() (string, error) {
 		if c == "\n" {
 			l.line += 1
 			l.appendToken("\n")
+			str += c
 		} else if c == "'" {
 			l.next()
-			return str + c, nil			
-		} else if c == "\"" {
-			s, err := l.lexStringDoubleQuote()
-			str += s
-			if err != nil {
-				return str, err
+			if l.char() != "'" {
+				return str + c, nil
 			}
-		} else if c == "`" {
-			s, err := l.lexStringBackQuote()
-			str += s
-			if err != nil {
-				return str, err
+			// '' is an escaped single quote.
+			str += c + c
+		} else if c == "\\" && l.isMySQLDump() {
+			// MySQL: a backslash escapes the next character.
+			l.next()
+			if l.isOutOfRange() {
+				break
+			}
+			if l.char() == "\n" {
+				l.line += 1
+				l.appendToken("\n")
 			}
+			str += c + l.char()
 		} else {
 			str += c
 		}
@@ -430,6 +739,27 @@ func (l *lexer) lexStringSingleQuote() (string, error) {
 }
 
 
+// "]" cannot be escaped in [name].
+func (l *lexer) lexStringBracketQuote() (string, error) {
+	l.next()
+	str := "["
+	c := ""
+	for !l.isOutOfRange() {
+		c = l.char()
+		if c == "\n" {
+			l.line += 1
+			l.appendToken("\n")
+		}
+		str += c
+		l.next()
+		if c == "]" {
+			return str, nil
+		}
+	}
+	return str, l.lexError()
+}
+
+
 func (l *lexer) lexStringBackQuote() (string, error) {
 	l.next()
 	str := "`"
@@ -439,21 +769,14 @@ func (l *lexer) lexStringBackQuote() (string, error) {
 		if c == "\n" {
 			l.line += 1
 			l.appendToken("\n")
+			str += c
 		} else if c == "`" {
 			l.next()
-			return str + c, nil			
-		} else if c == "\"" {
-			s, err := l.lexStringDoubleQuote()
-			str += s
-			if err != nil {
-				return str, err
-			}
-		} else if c == "'" {
-			s, err := l.lexStringSingleQuote()
-			str += s
-			if err != nil {
-				return str, err
+			if l.char() != "`" {
+				return str + c, nil
 			}
+			// `` is an escaped back quote.
+			str += c + c
 		} else {
 			str += c
 		}
@@ -0,0 +1,351 @@
+package pgarchive
+
+import (
+	"os"
+	"io"
+	"fmt"
+	"bytes"
+	"bufio"
+
+	"github.com/kodaimura/ddlparse/internal/types"
+)
+
+
+/*
+////////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////////
+
+  Read():
+    Read the table of contents (TOC) of a pg_dump custom-format archive (pg_dump -Fc).
+    The header and the TOC are at the head of the archive; the data blocks
+    after them (compressed or not) are not read.
+
+  The layout follows pg_backup_archiver.c (archive versions 1.0 to 1.16):
+    header: "PGDMP", version (major, minor, revision), int size, offset size, format,
+            compression, creation time, database name, server and pg_dump versions
+    TOC: the number of entries, then each entry (dump id, tag, desc, defn, ...)
+
+////////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////////
+*/
+
+const magic = "PGDMP"
+
+const formatCustom = 1
+
+// MAKE_ARCHIVE_VERSION(major, minor, revision)
+func version(major, minor, revision int) int {
+	return major * 65536 + minor * 256 + revision
+}
+
+var (
+	version_1_0 = version(1, 0, 0)
+	version_1_2 = version(1, 2, 0)  // compression
+	version_1_3 = version(1, 3, 0)  // copyStmt
+	version_1_4 = version(1, 4, 0)  // creation time, database name
+	version_1_5 = version(1, 5, 0)  // dependencies
+	version_1_6 = version(1, 6, 0)  // namespace
+	version_1_7 = version(1, 7, 0)  // offset size
+	version_1_8 = version(1, 8, 0)  // tableoid
+	version_1_9 = version(1, 9, 0)  // withOids
+	version_1_10 = version(1, 10, 0)  // tablespace, server and pg_dump versions
+	version_1_11 = version(1, 11, 0)  // section
+	version_1_14 = version(1, 14, 0)  // tableam
+	version_1_15 = version(1, 15, 0)  // compression algorithm (1 byte)
+	version_1_16 = version(1, 16, 0)  // relkind
+	version_max = version_1_16
+)
+
+
+type reader struct {
+	r *bufio.Reader
+	version int
+	intSize int
+	offSize int
+}
+
+
+func ReadFile(path string) ([]types.PgDumpEntry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return []types.PgDumpEntry{}, err
+	}
+	defer file.Close()
+	return Read(file)
+}
+
+
+func Read(file io.Reader) ([]types.PgDumpEntry, error) {
+	r := &reader{r: bufio.NewReader(file)}
+	if err := r.readHeader(); err != nil {
+		return []types.PgDumpEntry{}, err
+	}
+	entries, err := r.readToc()
+	if err != nil {
+		return []types.PgDumpEntry{}, err
+	}
+	return entries, nil
+}
+
+
+func (r *reader) readHeader() error {
+	buf := make([]byte, len(magic))
+	if _, err := io.ReadFull(r.r, buf); err != nil || string(buf) != magic {
+		return fmt.Errorf("pgarchive: not a pg_dump archive")
+	}
+	major, err := r.readByte()
+	if err != nil {
+		return err
+	}
+	minor, err := r.readByte()
+	if err != nil {
+		return err
+	}
+	revision := 0
+	if major > 1 || (major == 1 && minor > 0) {
+		if revision, err = r.readByte(); err != nil {
+			return err
+		}
+	}
+	r.version = version(major, minor, revision)
+	if r.version < version_1_0 || r.version > version_max {
+		return fmt.Errorf("pgarchive: unsupported archive version %d.%d", major, minor)
+	}
+
+	if r.intSize, err = r.readByte(); err != nil {
+		return err
+	}
+	r.offSize = r.intSize
+	if r.version >= version_1_7 {
+		if r.offSize, err = r.readByte(); err != nil {
+			return err
+		}
+	}
+	if r.intSize < 1 || r.intSize > 8 || r.offSize < 1 || r.offSize > 8 {
+		return fmt.Errorf("pgarchive: invalid integer size %d", r.intSize)
+	}
+	format, err := r.readByte()
+	if err != nil {
+		return err
+	}
+	if format != formatCustom {
+		return fmt.Errorf("pgarchive: not a custom-format archive (format %d)", format)
+	}
+
+	if r.version >= version_1_15 {
+		_, err = r.readByte() // compression algorithm
+	} else if r.version >= version_1_4 {
+		_, err = r.readInt() // compression level
+	} else if r.version >= version_1_2 {
+		_, err = r.readByte()
+	}
+	if err != nil {
+		return err
+	}
+	if r.version >= version_1_4 {
+		// tm_sec, tm_min, tm_hour, tm_mday, tm_mon, tm_year, tm_isdst
+		for i := 0; i < 7; i++ {
+			if _, err := r.readInt(); err != nil {
+				return err
+			}
+		}
+		if _, _, err := r.readStr(); err != nil { // database name
+			return err
+		}
+	}
+	if r.version >= version_1_10 {
+		// server version, pg_dump version
+		for i := 0; i < 2; i++ {
+			if _, _, err := r.readStr(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+
+func (r *reader) readToc() ([]types.PgDumpEntry, error) {
+	count, err := r.readInt()
+	if err != nil {
+		return nil, err
+	}
+	if count < 0 {
+		return nil, fmt.Errorf("pgarchive: invalid number of TOC entries %d", count)
+	}
+	entries := []types.PgDumpEntry{}
+	for i := 0; i < count; i++ {
+		entry, err := r.readTocEntry()
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+
+/*
+  dumpId, hadDumper, [tableoid], oid, tag, desc, [section], defn, dropStmt,
+  [copyStmt], [namespace], [tablespace], [tableam], [relkind], owner, [withOids],
+  [dependencies ... NULL], data offset
+*/
+func (r *reader) readTocEntry() (types.PgDumpEntry, error) {
+	var entry types.PgDumpEntry
+	var err error
+	if entry.DumpID, err = r.readInt(); err != nil {
+		return entry, err
+	}
+	if _, err = r.readInt(); err != nil { // hadDumper
+		return entry, err
+	}
+	if r.version >= version_1_8 {
+		if err = r.skipStr(); err != nil { // tableoid
+			return entry, err
+		}
+	}
+	if err = r.skipStr(); err != nil { // oid
+		return entry, err
+	}
+	if entry.Tag, _, err = r.readStr(); err != nil {
+		return entry, err
+	}
+	if entry.Desc, _, err = r.readStr(); err != nil {
+		return entry, err
+	}
+	if r.version >= version_1_11 {
+		if _, err = r.readInt(); err != nil { // section
+			return entry, err
+		}
+	}
+	if entry.Defn, _, err = r.readStr(); err != nil {
+		return entry, err
+	}
+	if err = r.skipStr(); err != nil { // dropStmt
+		return entry, err
+	}
+	if r.version >= version_1_3 {
+		if err = r.skipStr(); err != nil { // copyStmt
+			return entry, err
+		}
+	}
+	if r.version >= version_1_6 {
+		if entry.Namespace, _, err = r.readStr(); err != nil {
+			return entry, err
+		}
+	}
+	if r.version >= version_1_10 {
+		if err = r.skipStr(); err != nil { // tablespace
+			return entry, err
+		}
+	}
+	if r.version >= version_1_14 {
+		if err = r.skipStr(); err != nil { // tableam
+			return entry, err
+		}
+	}
+	if r.version >= version_1_16 {
+		if _, err = r.readInt(); err != nil { // relkind
+			return entry, err
+		}
+	}
+	if entry.Owner, _, err = r.readStr(); err != nil {
+		return entry, err
+	}
+	if r.version >= version_1_9 {
+		if err = r.skipStr(); err != nil { // withOids
+			return entry, err
+		}
+	}
+	if r.version >= version_1_5 {
+		for {
+			_, null, err := r.readStr()
+			if err != nil {
+				return entry, err
+			}
+			if null {
+				break
+			}
+		}
+	}
+	if err = r.skipOffset(); err != nil { // the position of the data block
+		return entry, err
+	}
+	return entry, nil
+}
+
+
+func (r *reader) readByte() (int, error) {
+	b, err := r.r.ReadByte()
+	if err != nil {
+		return 0, fmt.Errorf("pgarchive: unexpected end of archive")
+	}
+	return int(b), nil
+}
+
+
+// A sign byte (1 for negative), then intSize bytes (least significant first).
+func (r *reader) readInt() (int, error) {
+	sign, err := r.readByte()
+	if err != nil {
+		return 0, err
+	}
+	value := 0
+	for i := 0; i < r.intSize; i++ {
+		b, err := r.readByte()
+		if err != nil {
+			return 0, err
+		}
+		value |= b << (i * 8)
+	}
+	if sign != 0 {
+		return -value, nil
+	}
+	return value, nil
+}
+
+
+// The length (readInt) and the bytes. The length -1 is NULL.
+func (r *reader) readStr() (string, bool, error) {
+	length, err := r.readInt()
+	if err != nil {
+		return "", false, err
+	}
+	if length < 0 {
+		return "", true, nil
+	}
+	var buf bytes.Buffer
+	if _, err := io.CopyN(&buf, r.r, int64(length)); err != nil {
+		return "", false, fmt.Errorf("pgarchive: unexpected end of archive")
+	}
+	return buf.String(), false, nil
+}
+
+
+func (r *reader) skipStr() error {
+	_, _, err := r.readStr()
+	return err
+}
+
+
+/*
+  The position of the data block (_ReadExtraToc of pg_backup_custom.c):
+  a flag byte and offSize bytes, or before version 1.7
+  the position and the data size as two ints.
+*/
+func (r *reader) skipOffset() error {
+	if r.version < version_1_7 {
+		for i := 0; i < 2; i++ {
+			if _, err := r.readInt(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for i := 0; i < 1 + r.offSize; i++ {
+		if _, err := r.readByte(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,348 @@
+package sqlitefile
+
+import (
+	"os"
+	"io"
+	"fmt"
+	"unicode/utf16"
+	"encoding/binary"
+
+	"github.com/kodaimura/ddlparse/internal/types"
+)
+
+
+/*
+////////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////////
+
+  Read():
+    Read the rows of sqlite_schema (sqlite_master) from a SQLite database file.
+    The table b-tree rooted at page 1 is walked as documented in
+    https://www.sqlite.org/fileformat.html, without cgo or sqlite3.
+
+  Only the database file is read. A WAL file (-wal) is not:
+  changes that are not checkpointed into the database file are not seen.
+
+////////////////////////////////////////////////////////////////////////////////////
+////////////////////////////////////////////////////////////////////////////////////
+*/
+
+const (
+	headerSize = 100
+	magic = "SQLite format 3\x00"
+)
+
+const (
+	pageInteriorTable = 0x05
+	pageLeafTable = 0x0d
+)
+
+const (
+	encodingUTF8 = 1
+	encodingUTF16le = 2
+	encodingUTF16be = 3
+)
+
+
+type reader struct {
+	file io.ReaderAt
+	pageSize int
+	usableSize int
+	encoding int
+	visited map[int]bool
+}
+
+
+func ReadFile(path string) ([]types.SQLiteMasterRow, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return []types.SQLiteMasterRow{}, err
+	}
+	defer file.Close()
+	return Read(file)
+}
+
+
+func Read(file io.ReaderAt) ([]types.SQLiteMasterRow, error) {
+	// a 0-byte file is a valid empty database (page 1 is written by the first change).
+	if n, _ := file.ReadAt(make([]byte, 1), 0); n == 0 {
+		return []types.SQLiteMasterRow{}, nil
+	}
+	r := &reader{file: file, visited: map[int]bool{}}
+	if err := r.readHeader(); err != nil {
+		return []types.SQLiteMasterRow{}, err
+	}
+	rows := []types.SQLiteMasterRow{}
+	err := r.walkTable(1, func(payload []byte) error {
+		values, err := r.readRecord(payload)
+		if err != nil {
+			return err
+		}
+		// type, name, tbl_name, rootpage, sql
+		if len(values) < 5 {
+			return fmt.Errorf("sqlitefile: sqlite_schema row has %d columns", len(values))
+		}
+		rows = append(rows, types.SQLiteMasterRow{
+			Type: values[0], Name: values[1], TblName: values[2], SQL: values[4],
+		})
+		return nil
+	})
+	if err != nil {
+		return []types.SQLiteMasterRow{}, err
+	}
+	return rows, nil
+}
+
+
+/*
+  The database header (the first 100 bytes of page 1).
+    offset 16: page size (1 means 65536)
+    offset 19: file format read version (1: rollback journal, 2: WAL)
+    offset 20: reserved bytes at the end of each page
+    offset 56: text encoding
+*/
+func (r *reader) readHeader() error {
+	header := make([]byte, headerSize)
+	if _, err := r.file.ReadAt(header, 0); err != nil {
+		return fmt.Errorf("sqlitefile: not a SQLite database")
+	}
+	if string(header[:16]) != magic {
+		return fmt.Errorf("sqlitefile: not a SQLite database")
+	}
+	r.pageSize = int(binary.BigEndian.Uint16(header[16:18]))
+	if r.pageSize == 1 {
+		r.pageSize = 65536
+	}
+	if r.pageSize < 512 || r.pageSize & (r.pageSize - 1) != 0 {
+		return fmt.Errorf("sqlitefile: invalid page size %d", r.pageSize)
+	}
+	if header[19] != 1 && header[19] != 2 {
+		return fmt.Errorf("sqlitefile: unsupported file format version %d", header[19])
+	}
+	r.usableSize = r.pageSize - int(header[20])
+	r.encoding = int(binary.BigEndian.Uint32(header[56:60]))
+	if r.encoding == 0 {
+		r.encoding = encodingUTF8
+	}
+	if r.encoding > encodingUTF16be {
+		return fmt.Errorf("sqlitefile: invalid text encoding %d", r.encoding)
+	}
+	return nil
+}
+
+
+// Pages are numbered from 1. Each page is read once (a loop means a corrupt file).
+func (r *reader) readPage(n int) ([]byte, error) {
+	if n < 1 || r.visited[n] {
+		return nil, fmt.Errorf("sqlitefile: invalid page number %d", n)
+	}
+	r.visited[n] = true
+	page := make([]byte, r.pageSize)
+	if _, err := r.file.ReadAt(page, int64(n - 1) * int64(r.pageSize)); err != nil {
+		return nil, fmt.Errorf("sqlitefile: page %d is out of the file", n)
+	}
+	return page, nil
+}
+
+
+/*
+  Call fn with the payload of each row of the table b-tree rooted at page n, in rowid order.
+    interior page: header (12 bytes), cells (4-byte left child page, rowid varint)
+    leaf page: header (8 bytes), cells (payload size varint, rowid varint, payload)
+  The b-tree header of page 1 follows the database header.
+*/
+func (r *reader) walkTable(n int, fn func([]byte) error) error {
+	page, err := r.readPage(n)
+	if err != nil {
+		return err
+	}
+	offset := 0
+	if n == 1 {
+		offset = headerSize
+	}
+	kind := page[offset]
+	cells := int(binary.BigEndian.Uint16(page[offset + 3:]))
+	pointers := offset + 8
+	if kind == pageInteriorTable {
+		pointers = offset + 12
+	} else if kind != pageLeafTable {
+		return fmt.Errorf("sqlitefile: page %d is not a table b-tree page", n)
+	}
+	if pointers + cells * 2 > r.usableSize {
+		return fmt.Errorf("sqlitefile: page %d is corrupt", n)
+	}
+
+	for i := 0; i < cells; i++ {
+		cell := int(binary.BigEndian.Uint16(page[pointers + i * 2:]))
+		if kind == pageInteriorTable {
+			if cell + 4 > r.usableSize {
+				return fmt.Errorf("sqlitefile: page %d is corrupt", n)
+			}
+			if err := r.walkTable(int(binary.BigEndian.Uint32(page[cell:])), fn); err != nil {
+				return err
+			}
+			continue
+		}
+		payload, err := r.readPayload(n, page, cell)
+		if err != nil {
+			return err
+		}
+		if err := fn(payload); err != nil {
+			return err
+		}
+	}
+	if kind == pageInteriorTable {
+		return r.walkTable(int(binary.BigEndian.Uint32(page[offset + 8:])), fn)
+	}
+	return nil
+}
+
+
+/*
+  The payload of a table leaf cell.
+  A payload larger than the page keeps its head in the cell,
+  followed by the first overflow page number. Each overflow page holds
+  the next overflow page number (0 for the last one) and the rest of the payload.
+*/
+func (r *reader) readPayload(n int, page []byte, cell int) ([]byte, error) {
+	size, k1 := readVarint(page[:r.usableSize], cell)
+	_, k2 := readVarint(page[:r.usableSize], cell + k1) // rowid
+	if k1 == 0 || k2 == 0 || size < 0 || size > 1 << 31 {
+		return nil, fmt.Errorf("sqlitefile: page %d is corrupt", n)
+	}
+	cell += k1 + k2
+
+	local := r.localSize(int(size))
+	if cell + local > r.usableSize || (local < int(size) && cell + local + 4 > r.usableSize) {
+		return nil, fmt.Errorf("sqlitefile: page %d is corrupt", n)
+	}
+	payload := append([]byte{}, page[cell:cell + local]...)
+	if local == int(size) {
+		return payload, nil
+	}
+
+	next := int(binary.BigEndian.Uint32(page[cell + local:]))
+	for len(payload) < int(size) {
+		if next == 0 {
+			return nil, fmt.Errorf("sqlitefile: overflow pages of page %d are missing", n)
+		}
+		overflow, err := r.readPage(next)
+		if err != nil {
+			return nil, err
+		}
+		length := min(int(size) - len(payload), r.usableSize - 4)
+		payload = append(payload, overflow[4:4 + length]...)
+		next = int(binary.BigEndian.Uint32(overflow))
+	}
+	return payload, nil
+}
+
+
+// The number of payload bytes kept in a table leaf cell.
+func (r *reader) localSize(size int) int {
+	x := r.usableSize - 35
+	if size <= x {
+		return size
+	}
+	m := (r.usableSize - 12) * 32 / 255 - 23
+	k := m + (size - m) % (r.usableSize - 4)
+	if k <= x {
+		return k
+	}
+	return m
+}
+
+
+/*
+  A record is a header (its size varint and a serial type varint for each column)
+  followed by the values. Text values are returned as strings;
+  NULL, numbers and blobs are returned as "".
+    0: NULL, 1-6: integer (1, 2, 3, 4, 6, 8 bytes), 7: float, 8, 9: 0 and 1
+    N >= 12 and even: blob of (N - 12) / 2 bytes
+    N >= 13 and odd: text of (N - 13) / 2 bytes
+*/
+func (r *reader) readRecord(payload []byte) ([]string, error) {
+	headerSize, k := readVarint(payload, 0)
+	if k == 0 || headerSize > int64(len(payload)) {
+		return nil, fmt.Errorf("sqlitefile: invalid record")
+	}
+	values := []string{}
+	i, body := k, int(headerSize)
+	for i < int(headerSize) {
+		serialType, k := readVarint(payload[:headerSize], i)
+		if k == 0 {
+			return nil, fmt.Errorf("sqlitefile: invalid record")
+		}
+		i += k
+		size := serialTypeSize(serialType)
+		if size < 0 || body + size > len(payload) {
+			return nil, fmt.Errorf("sqlitefile: invalid record")
+		}
+		if serialType >= 13 && serialType % 2 == 1 {
+			values = append(values, r.decodeText(payload[body:body + size]))
+		} else {
+			values = append(values, "")
+		}
+		body += size
+	}
+	return values, nil
+}
+
+
+func serialTypeSize(serialType int64) int {
+	switch (serialType) {
+		case 0, 8, 9:
+			return 0
+		case 1, 2, 3, 4:
+			return int(serialType)
+		case 5:
+			return 6
+		case 6, 7:
+			return 8
+		case 10, 11:
+			return -1
+	}
+	if serialType % 2 == 0 {
+		return int(serialType - 12) / 2
+	}
+	return int(serialType - 13) / 2
+}
+
+
+func (r *reader) decodeText(text []byte) string {
+	if r.encoding == encodingUTF8 {
+		return string(text)
+	}
+	units := make([]uint16, len(text) / 2)
+	for i := range units {
+		if r.encoding == encodingUTF16le {
+			units[i] = binary.LittleEndian.Uint16(text[i * 2:])
+		} else {
+			units[i] = binary.BigEndian.Uint16(text[i * 2:])
+		}
+	}
+	return string(utf16.Decode(units))
+}
+
+
+/*
+  A varint is 1 to 9 bytes, big-endian. The first 8 bytes hold 7 bits each
+  (the high bit is set when another byte follows) and the 9th byte holds 8 bits.
+  Return the value and its length (0 if b ends in the middle of the varint).
+*/
+func readVarint(b []byte, i int) (int64, int) {
+	var value int64
+	for k := 0; k < 9; k++ {
+		if i + k >= len(b) {
+			return 0, 0
+		}
+		if k == 8 {
+			return value << 8 | int64(b[i + k]), 9
+		}
+		value = value << 7 | int64(b[i + k] & 0x7f)
+		if b[i + k] & 0x80 == 0 {
+			return value, k + 1
+		}
+	}
+	return value, 9
+}
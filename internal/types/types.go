@@ -1,41 +1,264 @@
 package types
 
 
+type Result struct {
+	Tables []Table `json:"tables"`
+	Sequences []Sequence `json:"sequences"`
+	Schemas []Schema `json:"schemas,omitempty"`
+}
+
+/*
+  Schema is created by CREATE SCHEMA (or CREATE DATABASE in MySQL).
+  Database is set when it was written as CREATE DATABASE.
+*/
+type Schema struct {
+	Name string `json:"name"`
+	NameQuoted bool `json:"name_quoted,omitempty"`
+	IfNotExists bool `json:"if_not_exists"`
+	Database bool `json:"database,omitempty"`
+	Authorization string `json:"authorization,omitempty"`
+	Charset string `json:"charset,omitempty"`
+	Collate string `json:"collate,omitempty"`
+}
+
+/*
+  SQLiteMasterRow is a row of sqlite_master (sqlite_schema).
+  SQL is empty for the indexes created by UNIQUE and PRIMARY KEY constraints.
+*/
+type SQLiteMasterRow struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+	TblName string `json:"tbl_name"`
+	SQL string `json:"sql"`
+}
+
+/*
+  PgDumpEntry is an entry of the table of contents of a pg_dump custom-format archive.
+  Desc is the kind of the object (TABLE, CONSTRAINT, INDEX, ...) and Defn is its DDL.
+*/
+type PgDumpEntry struct {
+	DumpID int `json:"dump_id"`
+	Desc string `json:"desc"`
+	Tag string `json:"tag"`
+	Namespace string `json:"namespace"`
+	Owner string `json:"owner"`
+	Defn string `json:"defn"`
+}
+
 type Table struct {
 	Schema string `json:"schema"`
 	Name string `json:"name"`
+	SchemaQuoted bool `json:"schema_quoted,omitempty"`
+	NameQuoted bool `json:"name_quoted,omitempty"`
 	IfNotExists bool `json:"if_not_exists"`
+	Temporary bool `json:"temporary,omitempty"`
+	Unlogged bool `json:"unlogged,omitempty"`
+	OnCommit string `json:"on_commit,omitempty"`
 	Columns []Column `json:"columns"`
 	Constraints TableConstraint `json:"constraints"`
+	WithoutRowid bool `json:"without_rowid,omitempty"`
+	Internal bool `json:"internal,omitempty"`
+	// SQLite: CREATE VIRTUAL TABLE ... USING module(module arguments as written).
+	Virtual bool `json:"virtual,omitempty"`
+	Module string `json:"module,omitempty"`
+	ModuleArguments []string `json:"module_arguments,omitempty"`
+	Query string `json:"query,omitempty"`
+	Comment string `json:"comment,omitempty"`
+	Doc string `json:"doc,omitempty"`
 }
 
 type Column struct {
 	Name string `json:"name"`
+	NameQuoted bool `json:"name_quoted,omitempty"`
 	DataType DataType `json:"data_type"`
 	Constraint Constraint `json:"constraint"`
+	Comment string `json:"comment,omitempty"`
+	Doc string `json:"doc,omitempty"`
 }
 
 type DataType struct {
 	Name string `json:"name"`
 	DigitN int `json:"digit_n"`
 	DigitM int `json:"digit_m"`
+	Unsigned bool `json:"unsigned,omitempty"`
+	Zerofill bool `json:"zerofill,omitempty"`
+	Charset string `json:"charset,omitempty"`
+	Values []string `json:"values,omitempty"`
+	// PostgreSQL: the number of array dimensions (1 for text[]).
+	Dimensions int `json:"dimensions,omitempty"`
 }
 
 type Constraint struct {
 	Name string `json:"name"`
+	NameQuoted bool `json:"name_quoted,omitempty"`
 	IsPrimaryKey bool `json:"is_primary_key"`
 	IsUnique bool `json:"is_unique"`
 	IsNotNull bool `json:"is_not_null"`
 	IsAutoincrement bool `json:"is_autoincrement"`
+	IsRowidAlias bool `json:"is_rowid_alias,omitempty"`
 	Default interface{} `json:"default"`
+	DefaultValue *DefaultValue `json:"default_value,omitempty"`
+	Generated *Generated `json:"generated,omitempty"`
+	OnUpdate string `json:"on_update,omitempty"`
 	Check string `json:"check"`
+	CheckExpression *Expression `json:"check_expression,omitempty"`
 	Collate string `json:"collate"`
 	References Reference `json:"references"`
+	Identity *Identity `json:"identity,omitempty"`
+	Sequence string `json:"sequence,omitempty"`
+	SequenceSchema string `json:"sequence_schema,omitempty"`
+	NullsNotDistinct bool `json:"nulls_not_distinct,omitempty"`
+	IndexParameters *IndexParameters `json:"index_parameters,omitempty"`
+	PrimaryKeyOrder string `json:"primary_key_order,omitempty"`
+	PrimaryKeyOnConflict string `json:"primary_key_on_conflict,omitempty"`
+	UniqueOnConflict string `json:"unique_on_conflict,omitempty"`
+	NotNullOnConflict string `json:"not_null_on_conflict,omitempty"`
+	PrimaryKeyName string `json:"primary_key_name,omitempty"`
+	UniqueName string `json:"unique_name,omitempty"`
+	NotNullName string `json:"not_null_name,omitempty"`
+	CheckName string `json:"check_name,omitempty"`
+	DefaultName string `json:"default_name,omitempty"`
+	ReferencesName string `json:"references_name,omitempty"`
+}
+
+/*
+  DefaultValue is nil when the column has no DEFAULT.
+  Value keeps the text as written (numbers are not rounded, strings are unquoted).
+  A literal with a cast ('active'::character varying) has the cast in Expression.
+*/
+type DefaultValue struct {
+	Kind string `json:"kind"`
+	Value string `json:"value,omitempty"`
+	Expression *Expression `json:"expression,omitempty"`
+}
+
+const (
+	DefaultNull = "null"
+	DefaultNumber = "number"
+	DefaultString = "string"
+	DefaultBoolean = "boolean"
+	DefaultKeyword = "keyword"
+	DefaultFunction = "function"
+	DefaultExpression = "expression"
+)
+
+// GENERATED ALWAYS AS (expr) [STORED | VIRTUAL]
+type Generated struct {
+	Expression
+	Stored bool `json:"stored"`
+}
+
+/*
+  Expression keeps the source text of an expression as written and its tree.
+  Tree is nil when the expression is not supported by the parser.
+*/
+type Expression struct {
+	Source string `json:"source"`
+	Tree *Expr `json:"tree,omitempty"`
+}
+
+/*
+  Expr is a node of an expression tree. 
+  The fields used depend on Kind:
+    number, string, boolean, keyword: Value
+    null, star: -
+    column: Table, Name
+    unary: Op, Operand
+    binary: Op, Left, Right (Not for IS NOT)
+    like: Op (LIKE, ILIKE, GLOB, ...), Left, Right, Escape, Not
+    in: Operand, Args, Not
+    between: Operand, Args (low and high), Not, Op (BETWEEN SYMMETRIC)
+    function: Name, Args
+    cast: Operand, Type
+    collate: Operand, Name
+    case: Operand (simple CASE), Whens, Else
+*/
+type Expr struct {
+	Kind string `json:"kind"`
+	Op string `json:"op,omitempty"`
+	Not bool `json:"not,omitempty"`
+	Value string `json:"value,omitempty"`
+	Table string `json:"table,omitempty"`
+	Name string `json:"name,omitempty"`
+	Type string `json:"type,omitempty"`
+	Operand *Expr `json:"operand,omitempty"`
+	Left *Expr `json:"left,omitempty"`
+	Right *Expr `json:"right,omitempty"`
+	Escape *Expr `json:"escape,omitempty"`
+	Args []*Expr `json:"args,omitempty"`
+	Whens []CaseWhen `json:"whens,omitempty"`
+	Else *Expr `json:"else,omitempty"`
+}
+
+type CaseWhen struct {
+	When *Expr `json:"when"`
+	Then *Expr `json:"then"`
 }
 
+const (
+	ExprNumber = "number"
+	ExprString = "string"
+	ExprBoolean = "boolean"
+	ExprNull = "null"
+	ExprKeyword = "keyword"
+	ExprStar = "star"
+	ExprColumn = "column"
+	ExprUnary = "unary"
+	ExprBinary = "binary"
+	ExprLike = "like"
+	ExprIn = "in"
+	ExprBetween = "between"
+	ExprFunction = "function"
+	ExprCast = "cast"
+	ExprCollate = "collate"
+	ExprCase = "case"
+)
+
+type Identity struct {
+	Always bool `json:"always"`
+	SequenceOptions
+}
+
+type SequenceOptions struct {
+	DataType string `json:"data_type,omitempty"`
+	Start *int64 `json:"start,omitempty"`
+	Increment *int64 `json:"increment,omitempty"`
+	MinValue *int64 `json:"min_value,omitempty"`
+	MaxValue *int64 `json:"max_value,omitempty"`
+	Cache *int64 `json:"cache,omitempty"`
+	Cycle bool `json:"cycle"`
+}
+
+type Sequence struct {
+	Schema string `json:"schema"`
+	Name string `json:"name"`
+	SchemaQuoted bool `json:"schema_quoted,omitempty"`
+	NameQuoted bool `json:"name_quoted,omitempty"`
+	IfNotExists bool `json:"if_not_exists"`
+	SequenceOptions
+	OwnedBy *SequenceOwner `json:"owned_by"`
+}
+
+type SequenceOwner struct {
+	Schema string `json:"schema"`
+	TableName string `json:"table_name"`
+	ColumnName string `json:"column_name"`
+	SchemaQuoted bool `json:"schema_quoted,omitempty"`
+	TableNameQuoted bool `json:"table_name_quoted,omitempty"`
+	ColumnNameQuoted bool `json:"column_name_quoted,omitempty"`
+}
+
+/*
+  ColumnNamesQuoted (here and in the table constraints) is set 
+  only when one of the column names is quoted.
+*/
 type Reference struct {
+	Schema string `json:"schema,omitempty"`
 	TableName string `json:"table_name"`
 	ColumnNames []string `json:"column_names"`
+	SchemaQuoted bool `json:"schema_quoted,omitempty"`
+	TableNameQuoted bool `json:"table_name_quoted,omitempty"`
+	ColumnNamesQuoted []bool `json:"column_names_quoted,omitempty"`
 }
 
 type TableConstraint struct {
@@ -43,25 +266,60 @@ type TableConstraint struct {
 	Unique []Unique `json:"unique"`
 	Check []Check `json:"check"`
 	ForeignKey []ForeignKey `json:"foreign_key"`
+	Exclude []Exclude `json:"exclude,omitempty"`
 }
 
 type PrimaryKey struct {
 	Name string `json:"name"`
+	NameQuoted bool `json:"name_quoted,omitempty"`
 	ColumnNames []string `json:"column_names"`
+	ColumnNamesQuoted []bool `json:"column_names_quoted,omitempty"`
+	ColumnOrders []string `json:"column_orders,omitempty"`
+	OnConflict string `json:"on_conflict,omitempty"`
+	IndexParameters
 }
 
 type Unique struct {
 	Name string `json:"name"`
+	NameQuoted bool `json:"name_quoted,omitempty"`
 	ColumnNames []string `json:"column_names"`
+	ColumnNamesQuoted []bool `json:"column_names_quoted,omitempty"`
+	NullsNotDistinct bool `json:"nulls_not_distinct,omitempty"`
+	OnConflict string `json:"on_conflict,omitempty"`
+	IndexParameters
+}
+
+type IndexParameters struct {
+	Include []string `json:"include,omitempty"`
+	StorageParameters map[string]string `json:"storage_parameters,omitempty"`
+	Tablespace string `json:"tablespace,omitempty"`
 }
 
 type Check struct {
 	Name string `json:"name"`
+	NameQuoted bool `json:"name_quoted,omitempty"`
 	Expr string `json:"expr"`
+	Expression *Expression `json:"expression,omitempty"`
 }
 
 type ForeignKey struct {
 	Name string `json:"name"`
+	NameQuoted bool `json:"name_quoted,omitempty"`
 	ColumnNames []string `json:"column_names"`
+	ColumnNamesQuoted []bool `json:"column_names_quoted,omitempty"`
 	References Reference `json:"references"`
+}
+
+type Exclude struct {
+	Name string `json:"name"`
+	NameQuoted bool `json:"name_quoted,omitempty"`
+	IndexMethod string `json:"index_method"`
+	Elements []ExcludeElement `json:"elements"`
+	IndexParameters
+	Where string `json:"where"`
+}
+
+type ExcludeElement struct {
+	Element string `json:"element"`
+	Operator string `json:"operator"`
 }
\ No newline at end of file
@@ -32,12 +32,23 @@ func NewValidator(rdbms common.Rdbms) Validator {
 	return NewSQLiteValidator()
 }
 
+func NewValidatorWithOptions(rdbms common.Rdbms, options common.Options) Validator {
+	if rdbms == common.PostgreSQL {
+		return &postgresqlValidator{validator: validator{options: options}}
+	} else if rdbms == common.MySQL {
+		return &mysqlValidator{validator: validator{options: options}}
+	}
+	return &sqliteValidator{validator: validator{options: options}}
+}
+
 type validator struct {
+	options common.Options
 	tokens []string
 	size int
 	i int
 	line int
 	result []string
+	trivia []string
 }
 
 
@@ -47,9 +58,8 @@ func (v *validator) init(tokens []string) {
 	v.i = 0
 	v.line = 1
 	v.result = []string{}
-	if v.token() == "\n" {
-		v.next()
-	}
+	v.trivia = []string{}
+	v.skipTrivia(true)
 }
 
 
@@ -76,18 +86,66 @@ func (v *validator) next() string {
 		return common.EOF
 	}
 	token := v.token()
-	for true {
-		v.i += 1
-		if v.isOutOfRange() {
-			break
-		} else if (v.token() == "\n") {
+	v.result = append(v.result, v.trivia...)
+	v.trivia = []string{}
+	v.i += 1
+	v.skipTrivia(false)
+	return token
+}
+
+
+func (v *validator) isTrivia(token string) bool {
+	return common.IsTriviaToken(token)
+}
+
+
+/*
+  Skip line breaks, spaces and comments.
+  They are kept and set just before the next token.
+*/
+func (v *validator) skipTrivia(newline bool) {
+	for !v.isOutOfRange() {
+		if v.token() == "\n" {
 			v.line += 1
-			continue
+			newline = true
+			v.trivia = append(v.trivia, v.token())
+		} else if common.IsSpaceToken(v.token()) {
+			v.trivia = append(v.trivia, v.token())
+		} else if common.IsCommentToken(v.token()) {
+			if newline {
+				v.trivia = append(v.trivia, v.token())
+			} else {
+				v.trivia = append(v.trivia, common.TrailingComment(v.token()))
+			}
 		} else {
 			break
 		}
+		v.i += 1
 	}
-	return token
+}
+
+
+/*
+  Drop the trivia set by a statement that set no other tokens
+  (a statement that is not subject to conversion).
+*/
+func (v *validator) dropTrivia(begin int) {
+	for _, token := range v.result[begin:] {
+		if !v.isTrivia(token) {
+			return
+		}
+	}
+	v.result = v.result[:begin]
+}
+
+
+func (v *validator) peek() string {
+	for i := v.i + 1; i < v.size; i++ {
+		if !v.isTrivia(v.tokens[i]) {
+			return v.tokens[i]
+		}
+	}
+	return common.EOF
 }
 
 
@@ -164,4 +222,61 @@ func (v *validator) validateBracketsAux(set bool) error {
 		v.next()
 	}
 	return v.validateBracketsAux(set)
+}
+
+
+/*
+  AS query, or [(column_name, ...)] [table options] AS query:
+  "AS" outside the brackets before the end of the statement.
+*/
+func (v *validator) isCreateTableAs() bool {
+	depth := 0
+	for i := v.i; i < v.size; i++ {
+		if v.tokens[i] == "(" {
+			depth += 1
+		} else if v.tokens[i] == ")" {
+			depth -= 1
+		} else if depth == 0 && v.tokens[i] == ";" {
+			return false
+		} else if depth == 0 && strings.ToUpper(v.tokens[i]) == "AS" {
+			return true
+		}
+	}
+	return false
+}
+
+
+/*
+  Collect the tokens of a query up to ";" (or PostgreSQL's WITH [NO] DATA)
+  and set them as a single token.
+*/
+func (v *validator) validateQuery() error {
+	if !v.matchToken("SELECT", "WITH", "VALUES", "TABLE") {
+		return v.syntaxError()
+	}
+	tokens := []string{}
+	depth := 0
+	for !v.isOutOfRange() {
+		if depth == 0 && v.matchToken(";") {
+			break
+		}
+		if depth == 0 && len(tokens) > 0 && v.matchToken("WITH") &&
+			common.Contains([]string{"NO", "DATA"}, strings.ToUpper(v.peek())) {
+			break
+		}
+		if v.matchToken("(") {
+			depth += 1
+		} else if v.matchToken(")") {
+			depth -= 1
+			if depth < 0 {
+				return v.syntaxError()
+			}
+		}
+		tokens = append(tokens, v.next())
+	}
+	if depth != 0 {
+		return v.syntaxError()
+	}
+	v.set(common.JoinTokens(tokens))
+	return nil
 }
\ No newline at end of file
@@ -2,6 +2,7 @@ package validator
 
 import (
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/kodaimura/ddlparse/internal/common"
@@ -29,9 +30,11 @@ func (v *mysqlValidator) validate() error {
 	if (v.isOutOfRange()) {
 		return nil
 	}
+	begin := len(v.result)
 	if err := v.validateDdl(); err != nil {
 		return err
 	}
+	v.dropTrivia(begin)
 	return v.validate()
 }
 
@@ -193,7 +196,7 @@ func (v *mysqlValidator) validateIndexKeys(set bool) error {
 	if err := v.validateIndexKeysAux(set); err != nil {
 		return err
 	}
-	if err := v.validateToken(true, ")"); err != nil {
+	if err := v.validateToken(set, ")"); err != nil {
 		return err
 	}
 	return nil
@@ -221,11 +224,33 @@ func (v *mysqlValidator) validateIndexKeysAux(set bool) error {
 
 
 func (v *mysqlValidator) validateDdl() error {
+	if v.matchToken("USE") {
+		return v.validateUse()
+	}
+	if v.matchToken("DROP") {
+		return v.validateDrop()
+	}
+	// a statement in a versioned comment that is not executed leaves its ";".
+	if v.options.MySQLDump && v.matchTokenNext(false, ";") {
+		return nil
+	}
+	if v.options.MySQLDump && v.matchToken(
+		"SET", "LOCK", "UNLOCK", "INSERT", "REPLACE", "ALTER", "START", "COMMIT", "DELIMITER",
+	) {
+		return v.validateDumpStatement()
+	}
 	if err := v.validateToken(false, "CREATE"); err != nil {
 		return err
 	}
+	temporary := v.matchTokenNext(false, "TEMPORARY")
 	if v.matchToken("TABLE") {
-		if err := v.validateCreateTable(); err != nil {
+		if err := v.validateCreateTable(temporary); err != nil {
+			return err
+		}
+	} else if temporary {
+		return v.syntaxError()
+	} else if v.matchToken("DATABASE", "SCHEMA") && strings.ToUpper(v.peek()) != "LINK" {
+		if err := v.validateCreateDatabase(); err != nil {
 			return err
 		}
 	} else {
@@ -237,8 +262,94 @@ func (v *mysqlValidator) validateDdl() error {
 }
 
 
-func (v *mysqlValidator) validateCreateTable() error {
+// USE db_name;
+func (v *mysqlValidator) validateUse() error {
+	if err := v.validateToken(true, "USE"); err != nil {
+		return err
+	}
+	if err := v.validateName(true); err != nil {
+		return err
+	}
+	if err := v.validateToken(true, ";"); err != nil {
+		return err
+	}
+	return nil
+}
+
+
+// CREATE {DATABASE | SCHEMA} [IF NOT EXISTS] db_name [create_option] ...;
+func (v *mysqlValidator) validateCreateDatabase() error {
+	v.set("CREATE")
+	if err := v.validateToken(true, "DATABASE", "SCHEMA"); err != nil {
+		return err
+	}
+	if err := v.validateIfNotExists(); err != nil {
+		return err
+	}
+	if err := v.validateName(true); err != nil {
+		return err
+	}
+	if err := v.validateDatabaseOptions(); err != nil {
+		return err
+	}
+	if err := v.validateToken(true, ";"); err != nil {
+		return err
+	}
+	return nil
+}
+
+
+func (v *mysqlValidator) validateDatabaseOptions() error {
+	if v.isOutOfRange() || v.matchToken(";") {
+		return nil
+	}
+	if err := v.validateDatabaseOption(); err != nil {
+		return err
+	}
+	return v.validateDatabaseOptions()
+}
+
+
+// [DEFAULT] {CHARACTER SET | CHARSET} [=] charset_name
+// | [DEFAULT] COLLATE [=] collation_name
+// | [DEFAULT] ENCRYPTION [=] {'Y' | 'N'}
+// | READ ONLY [=] {DEFAULT | 0 | 1}
+func (v *mysqlValidator) validateDatabaseOption() error {
+	if v.matchTokenNext(false, "READ") {
+		if err := v.validateToken(false, "ONLY"); err != nil {
+			return err
+		}
+		v.matchTokenNext(false, "=")
+		return v.validateToken(false, "DEFAULT", "0", "1")
+	}
+	v.matchTokenNext(false, "DEFAULT")
+	if v.matchTokenNext(false, "ENCRYPTION") {
+		v.matchTokenNext(false, "=")
+		return v.validateStringValue(false)
+	}
+	if v.matchTokenNext(false, "COLLATE") {
+		v.set("COLLATE")
+	} else {
+		if v.matchTokenNext(false, "CHARACTER") {
+			if err := v.validateToken(false, "SET"); err != nil {
+				return err
+			}
+		} else if err := v.validateToken(false, "CHARSET"); err != nil {
+			return err
+		}
+		v.set("CHARACTER")
+		v.set("SET")
+	}
+	v.matchTokenNext(false, "=")
+	return v.validateName(true)
+}
+
+
+func (v *mysqlValidator) validateCreateTable(temporary bool) error {
 	v.set("CREATE")
+	if temporary {
+		v.set("TEMPORARY")
+	}
 	if err := v.validateToken(true, "TABLE"); err != nil {
 		return err
 	}
@@ -248,7 +359,15 @@ func (v *mysqlValidator) validateCreateTable() error {
 	if err := v.validateTableName(true); err != nil {
 		return err
 	}
-	if err := v.validateTableDefinition(); err != nil {
+	if v.matchToken("AS", "SELECT") {
+		if err := v.validateCreateTableAs(); err != nil {
+			return err
+		}
+	} else if v.matchToken("LIKE") || (v.matchToken("(") && strings.ToUpper(v.peek()) == "LIKE") {
+		if err := v.validateCreateTableLike(); err != nil {
+			return err
+		}
+	} else if err := v.validateTableDefinition(); err != nil {
 		return err
 	}
 	if err := v.validateToken(true, ";"); err != nil {
@@ -258,7 +377,111 @@ func (v *mysqlValidator) validateCreateTable() error {
 }
 
 
+// [AS] SELECT ...
+func (v *mysqlValidator) validateCreateTableAs() error {
+	v.matchTokenNext(false, "AS")
+	v.set("AS")
+	if !v.matchToken("SELECT") {
+		return v.syntaxError()
+	}
+	return v.validateQuery()
+}
+
+
+// LIKE old_table_name | (LIKE old_table_name)
+func (v *mysqlValidator) validateCreateTableLike() error {
+	bracket := v.matchTokenNext(false, "(")
+	v.set("(")
+	if err := v.validateToken(true, "LIKE"); err != nil {
+		return err
+	}
+	if err := v.validateTableName(true); err != nil {
+		return err
+	}
+	if bracket {
+		if err := v.validateToken(false, ")"); err != nil {
+			return err
+		}
+	}
+	v.set(")")
+	return nil
+}
+
+
+/*
+  MySQLDump: the statements that do not define the schema are skipped.
+    SET ...; LOCK TABLES ...; UNLOCK TABLES; INSERT ...; REPLACE ...;
+    START TRANSACTION; COMMIT;
+    ALTER TABLE tbl_name {DISABLE | ENABLE} KEYS;
+    DELIMITER ;; ... DELIMITER ;
+*/
+func (v *mysqlValidator) validateDumpStatement() error {
+	if v.matchToken("DELIMITER") {
+		return v.validateDelimiterBlock()
+	}
+	if v.matchTokenNext(false, "ALTER") {
+		if err := v.validateToken(false, "TABLE"); err != nil {
+			return err
+		}
+		if err := v.validateTableName(false); err != nil {
+			return err
+		}
+		if err := v.validateToken(false, "DISABLE", "ENABLE"); err != nil {
+			return err
+		}
+		if err := v.validateToken(false, "KEYS"); err != nil {
+			return err
+		}
+	} else if v.matchTokenNext(false, "LOCK", "UNLOCK") {
+		if err := v.validateToken(false, "TABLES", "TABLE"); err != nil {
+			return err
+		}
+	} else if v.matchTokenNext(false, "START") {
+		if err := v.validateToken(false, "TRANSACTION"); err != nil {
+			return err
+		}
+	} else {
+		v.next()
+	}
+	for !v.matchToken(";") {
+		if v.isOutOfRange() {
+			return v.syntaxError()
+		}
+		v.next()
+	}
+	if err := v.validateToken(false, ";"); err != nil {
+		return err
+	}
+	return nil
+}
+
+
+// DELIMITER ;; ... DELIMITER ;
+// (mysqldump puts triggers, routines and events in the block, which are not kept.)
+func (v *mysqlValidator) validateDelimiterBlock() error {
+	if err := v.validateToken(false, "DELIMITER"); err != nil {
+		return err
+	}
+	for !v.matchToken("DELIMITER") {
+		if v.isOutOfRange() {
+			return v.syntaxError()
+		}
+		v.next()
+	}
+	if err := v.validateToken(false, "DELIMITER"); err != nil {
+		return err
+	}
+	if err := v.validateToken(false, ";"); err != nil {
+		return err
+	}
+	return nil
+}
+
+
 func (v *mysqlValidator) validateCreateOther() error {
+	if err := v.validateCreateOtherPrefix(); err != nil {
+		return err
+	}
 	if err := v.validateToken(false, 
 		"VIEW", "TRIGGER", "INDEX", "DATABASE", "UNIQUE", "PROCEDURE", "SERVER",
 		"FUNCTION", "USER", "EVENT", "SEQUENCE", "TABLESPACE", "ROLE", "LOGIN",
@@ -291,6 +514,190 @@ func (v *mysqlValidator) validateCreateOther() error {
 }
 
 
+func (v *mysqlValidator) validateDrop() error {
+	if err := v.validateToken(false, "DROP"); err != nil {
+		return err
+	}
+	if v.matchTokenNext(false, "TEMPORARY") && !v.matchToken("TABLE") {
+		return v.syntaxError()
+	}
+	if v.matchToken("TABLE", "VIEW") {
+		return v.validateDropTable()
+	}
+	if v.matchToken("DATABASE", "SCHEMA") {
+		return v.validateDropDatabase()
+	}
+	if v.matchToken("INDEX") {
+		return v.validateDropIndex()
+	}
+	return v.validateDropOther()
+}
+
+
+// DROP [TEMPORARY] TABLE [IF EXISTS] tbl_name [, tbl_name] ... [RESTRICT | CASCADE];
+// DROP VIEW [IF EXISTS] view_name [, view_name] ... [RESTRICT | CASCADE];
+func (v *mysqlValidator) validateDropTable() error {
+	isTable := v.matchToken("TABLE")
+	if isTable {
+		v.set("DROP")
+	}
+	if err := v.validateToken(isTable, "TABLE", "VIEW"); err != nil {
+		return err
+	}
+	if err := v.validateIfExists(); err != nil {
+		return err
+	}
+	if err := v.validateCommaSeparatedTableNames(isTable); err != nil {
+		return err
+	}
+	v.matchTokenNext(isTable, "RESTRICT", "CASCADE")
+	if err := v.validateToken(isTable, ";"); err != nil {
+		return err
+	}
+	return nil
+}
+
+
+// DROP {DATABASE | SCHEMA} [IF EXISTS] db_name;
+func (v *mysqlValidator) validateDropDatabase() error {
+	v.set("DROP")
+	if err := v.validateToken(true, "DATABASE", "SCHEMA"); err != nil {
+		return err
+	}
+	if err := v.validateIfExists(); err != nil {
+		return err
+	}
+	if err := v.validateName(true); err != nil {
+		return err
+	}
+	if err := v.validateToken(true, ";"); err != nil {
+		return err
+	}
+	return nil
+}
+
+
+// DROP INDEX index_name ON tbl_name [{ALGORITHM | LOCK} [=] value] ...;
+func (v *mysqlValidator) validateDropIndex() error {
+	v.set("DROP")
+	if err := v.validateToken(true, "INDEX"); err != nil {
+		return err
+	}
+	if err := v.validateName(true); err != nil {
+		return err
+	}
+	if err := v.validateToken(true, "ON"); err != nil {
+		return err
+	}
+	if err := v.validateTableName(true); err != nil {
+		return err
+	}
+	for v.matchTokenNext(false, "ALGORITHM", "LOCK") {
+		v.matchTokenNext(false, "=")
+		if err := v.validateToken(false, 
+			"DEFAULT", "INPLACE", "COPY", "INSTANT", "NONE", "SHARED", "EXCLUSIVE",
+		); err != nil {
+			return err
+		}
+	}
+	if err := v.validateToken(true, ";"); err != nil {
+		return err
+	}
+	return nil
+}
+
+
+func (v *mysqlValidator) validateDropOther() error {
+	if err := v.validateToken(false, 
+		"TRIGGER", "PROCEDURE", "FUNCTION", "EVENT", "USER", "ROLE", "SERVER", "TABLESPACE",
+	); err != nil {
+		return err
+	}
+	for !v.matchToken(";") {
+		if v.isOutOfRange() {
+			return v.syntaxError()
+		}
+		v.next()
+	}
+	if err := v.validateToken(false, ";"); err != nil {
+		return err
+	}
+	return nil
+}
+
+
+// [OR REPLACE] [ALGORITHM = {UNDEFINED | MERGE | TEMPTABLE}] [DEFINER = user] [SQL SECURITY {DEFINER | INVOKER}]
+func (v *mysqlValidator) validateCreateOtherPrefix() error {
+	if v.matchTokenNext(false, "OR") {
+		if err := v.validateToken(false, "REPLACE"); err != nil {
+			return err
+		}
+	}
+	if v.matchTokenNext(false, "ALGORITHM") {
+		if err := v.validateToken(false, "="); err != nil {
+			return err
+		}
+		if err := v.validateToken(false, "UNDEFINED", "MERGE", "TEMPTABLE"); err != nil {
+			return err
+		}
+	}
+	if v.matchTokenNext(false, "DEFINER") {
+		if err := v.validateToken(false, "="); err != nil {
+			return err
+		}
+		if err := v.validateUser(); err != nil {
+			return err
+		}
+	}
+	if v.matchTokenNext(false, "SQL") {
+		if err := v.validateToken(false, "SECURITY"); err != nil {
+			return err
+		}
+		if err := v.validateToken(false, "DEFINER", "INVOKER"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+
+// user_name[@host_name] | CURRENT_USER[()]
+func (v *mysqlValidator) validateUser() error {
+	if v.matchTokenNext(false, "CURRENT_USER") {
+		if v.matchTokenNext(false, "(") {
+			return v.validateToken(false, ")")
+		}
+		return nil
+	}
+	if err := v.validateUserPart(); err != nil {
+		return err
+	}
+	if v.matchTokenNext(false, "@") {
+		return v.validateUserPart()
+	}
+	return nil
+}
+
+
+func (v *mysqlValidator) validateUserPart() error {
+	if v.isStringValue(v.token()) {
+		v.next()
+		return nil
+	}
+	return v.validateName(false)
+}
+
+
+func (v *mysqlValidator) validateIfExists() error {
+	if v.matchTokenNext(false, "IF") {
+		if err := v.validateToken(false, "EXISTS"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+
 func (v *mysqlValidator) validateIfNotExists() error {
 	if v.matchTokenNext(true, "IF") {
 		if err := v.validateToken(true, "NOT"); err != nil {
@@ -322,16 +729,33 @@ func (v *mysqlValidator) validateTableDefinition() error {
 
 
 func (v *mysqlValidator) validateColumnDefinitions() error {
+	return v.validateColumnDefinitionsAux(false)
+}
+
+
+// INDEX, KEY, FULLTEXT and SPATIAL are not converted,
+// so the comma is set only between definitions that are.
+func (v *mysqlValidator) validateColumnDefinitionsAux(set bool) error {
+	set = set || !v.isIndexDefinition(v.token())
 	if err := v.validateColumnDefinition(); err != nil {
 		return err
 	}
-	if v.matchTokenNext(true, ",") {
-		return v.validateColumnDefinitions()
+	if v.matchTokenNext(set && !v.isIndexDefinition(v.peek()), ",") {
+		return v.validateColumnDefinitionsAux(set)
 	}
 	return nil
 }
 
 
+func (v *mysqlValidator) isIndexDefinition(token string) bool {
+	switch (strings.ToUpper(token)) {
+		case "INDEX", "KEY", "FULLTEXT", "SPATIAL":
+			return true
+	}
+	return false
+}
+
+
 func (v *mysqlValidator) validateColumnDefinition() error {
 	if v.matchToken("CONSTRAINT", "PRIMARY", "UNIQUE", "FOREIGN", "INDEX", "KEY", "FULLTEXT", "SPATIAL", "CHECK") {
 		return v.validateTableConstraint()
@@ -350,7 +774,18 @@ func (v *mysqlValidator) validateColumnDefinition() error {
 
 
 func (v *mysqlValidator) validateColumnType() error {
-	if v.matchTokenNext(true, "VARCHAR", "CHAR", "BINARY", "VARBINARY", "BLOB", "TEXT") {
+	if v.matchTokenNext(true, "VARCHAR", "CHAR", "TEXT") {
+		if err := v.validateTypeDigitN(true); err != nil {
+			return err
+		}
+		return v.validateCharset()
+	}
+
+	if v.matchTokenNext(true, "TINYTEXT", "MEDIUMTEXT", "LONGTEXT") {
+		return v.validateCharset()
+	}
+
+	if v.matchTokenNext(true, "BINARY", "VARBINARY", "BLOB") {
 		if err := v.validateTypeDigitN(true); err != nil {
 			return err
 		}
@@ -361,16 +796,25 @@ func (v *mysqlValidator) validateColumnType() error {
 		if err := v.validateTypeDigitPS(true); err != nil {
 			return err
 		}
+		v.validateNumericAttributes()
 		return nil
 	}
 
-	if v.matchTokenNext(true, "BIT", "TINYINT", "SMALLINT", "MEDIUMINT", "INT", "INTEGER", "BIGINT") {
+	if v.matchTokenNext(true, "BIT") {
 		if err := v.validateTypeDigitP(true); err != nil {
 			return err
 		}
 		return nil
 	}
 
+	if v.matchTokenNext(true, "TINYINT", "SMALLINT", "MEDIUMINT", "INT", "INTEGER", "BIGINT") {
+		if err := v.validateTypeDigitP(true); err != nil {
+			return err
+		}
+		v.validateNumericAttributes()
+		return nil
+	}
+
 	if v.matchTokenNext(true, "TIME", "DATETIME", "TIMESTAMP", "YEAR") {
 		if err := v.validateTypeDigitP(true); err != nil {
 			return err
@@ -386,8 +830,18 @@ func (v *mysqlValidator) validateColumnType() error {
 		return nil
 	}
 
-	// TODO if v.matchToken("ENUM") {}
-	// TODO if v.matchToken("SET") {}
+	if v.matchTokenNext(true, "ENUM", "SET") {
+		if err := v.validateToken(true, "("); err != nil {
+			return err
+		}
+		if err := v.validateCommaSeparatedStringValues(); err != nil {
+			return err
+		}
+		if err := v.validateToken(true, ")"); err != nil {
+			return err
+		}
+		return v.validateCharset()
+	}
 
 	if err := v.validateToken(true, DataType_MySQL...); err != nil {
 		return err
@@ -397,6 +851,41 @@ func (v *mysqlValidator) validateColumnType() error {
 }
 
 
+func (v *mysqlValidator) validateCommaSeparatedStringValues() error {
+	if err := v.validateStringValue(true); err != nil {
+		return err
+	}
+	if v.matchTokenNext(true, ",") {
+		return v.validateCommaSeparatedStringValues()
+	}
+	return nil
+}
+
+
+// [SIGNED | UNSIGNED] [ZEROFILL]
+func (v *mysqlValidator) validateNumericAttributes() {
+	if !v.matchTokenNext(false, "SIGNED") {
+		v.matchTokenNext(true, "UNSIGNED")
+	}
+	v.matchTokenNext(true, "ZEROFILL")
+}
+
+
+// [{CHARACTER SET | CHARSET} charset_name]
+func (v *mysqlValidator) validateCharset() error {
+	if v.matchTokenNext(false, "CHARACTER") {
+		if err := v.validateToken(false, "SET"); err != nil {
+			return err
+		}
+	} else if !v.matchTokenNext(false, "CHARSET") {
+		return nil
+	}
+	v.set("CHARACTER")
+	v.set("SET")
+	return v.validateName(true)
+}
+
+
 func (v *mysqlValidator) validateColumnConstraints() error {
 	if v.matchTokenNext(true, "CONSTRAINT") {
 		if !v.matchToken("CHECK") {
@@ -409,16 +898,38 @@ func (v *mysqlValidator) validateColumnConstraints() error {
 }
 
 
+// CONSTRAINT [symbol] CHECK (expr)
+func (v *mysqlValidator) validateConstraintName() error {
+	if err := v.validateToken(true, "CONSTRAINT"); err != nil {
+		return err
+	}
+	if !v.matchToken("CHECK") {
+		if err := v.validateName(true); err != nil {
+			return err
+		}
+	}
+	if !v.matchToken("CHECK") {
+		return v.syntaxError()
+	}
+	return nil
+}
+
+
 func (v *mysqlValidator) isColumnConstraint(token string) bool {
 	return v.matchToken(
 		"PRIMARY", "KEY", "NOT", "NULL", "UNIQUE", "CHECK", "DEFAULT", "COLLATE", "REFERENCES", 
 		"GENERATED", "AS", "COMMENT", "COLUMN_FORMAT", "ENGINE_ATTRIBUTE", "SECONDARY_ENGINE_ATTRIBUTE", 
-		"STORAGE", "VISIBLE", "INVISIBLE", "VIRTUAL", "STORED", "AUTO_INCREMENT",
+		"STORAGE", "VISIBLE", "INVISIBLE", "VIRTUAL", "STORED", "AUTO_INCREMENT", "ON",
 	)
 }
 
 
 func (v *mysqlValidator) validateColumnConstraintsAux(ls []string) error {
+	if v.matchToken("CONSTRAINT") {
+		if err := v.validateConstraintName(); err != nil {
+			return err
+		}
+	}
 	if !v.isColumnConstraint(v.token()) {
 		return nil
 	} 
@@ -468,6 +979,9 @@ func (v *mysqlValidator) validateColumnConstraint() error {
 	if v.matchToken("CHECK") {
 		return v.validateConstraintCheck()
 	}
+	if v.matchToken("ON") {
+		return v.validateConstraintOnUpdate()
+	}
 	if v.matchToken("DEFAULT") {
 		return v.validateConstraintDefault()
 	}
@@ -495,7 +1009,10 @@ func (v *mysqlValidator) validateColumnConstraint() error {
 	if v.matchToken("AUTO_INCREMENT") {
 		return v.validateConstraintAutoincrement()
 	}
-	if v.matchTokenNext(false, "VISIBLE", "INVISIBLE", "VIRTUAL", "STORED") {
+	if v.matchTokenNext(false, "VISIBLE", "INVISIBLE") {
+		return nil
+	}
+	if v.matchTokenNext(true, "VIRTUAL", "STORED") {
 		return nil
 	}
 	
@@ -569,18 +1086,49 @@ func (v *mysqlValidator) validateConstraintDefault() error {
 		if err := v.validateExpr(true); err != nil {
 			return err
 		}
+	} else if v.matchToken("CURRENT_TIME", "CURRENT_TIMESTAMP", "LOCALTIME", "LOCALTIMESTAMP", "NOW") {
+		if err := v.validateCurrentTimestamp(); err != nil {
+			return err
+		}
 	} else {
 		if err := v.validateLiteralValue(true); err != nil {
 			return err
 		}
+	}
+	return nil
+}
 
-		if v.matchTokenNext(false, "ON") {
-			if err := v.validateToken(false, "UPDATE"); err != nil {
-				return err
-			}
-			if err := v.validateToken(false, "CURRENT_TIME", "CURRENT_DATE", "CURRENT_TIMESTAMP"); err != nil {
-				return err
+
+func (v *mysqlValidator) validateConstraintOnUpdate() error {
+	if err := v.validateToken(true, "ON"); err != nil {
+		return err
+	}
+	if err := v.validateToken(true, "UPDATE"); err != nil {
+		return err
+	}
+	if err := v.validateCurrentTimestamp(); err != nil {
+		return err
+	}
+	return nil
+}
+
+
+// CURRENT_TIMESTAMP [([fsp])] and its synonyms
+func (v *mysqlValidator) validateCurrentTimestamp() error {
+	if err := v.validateToken(true,
+		"CURRENT_TIME", "CURRENT_DATE", "CURRENT_TIMESTAMP", "LOCALTIME", "LOCALTIMESTAMP", "NOW",
+	); err != nil {
+		return err
+	}
+	if v.matchTokenNext(true, "(") {
+		if !v.matchToken(")") {
+			if n, err := strconv.Atoi(v.token()); err != nil || n < 0 || n > 6 {
+				return v.syntaxError()
 			}
+			v.set(v.next())
+		}
+		if err := v.validateToken(true, ")"); err != nil {
+			return err
 		}
 	}
 	return nil
@@ -661,7 +1209,10 @@ func (v *mysqlValidator) validateConstraintGenerated() error {
 	if err := v.validateToken(false, "AS"); err != nil {
 		return err
 	}
-	if err := v.validateExpr(false); err != nil {
+	v.set("GENERATED")
+	v.set("ALWAYS")
+	v.set("AS")
+	if err := v.validateExpr(true); err != nil {
 		return err
 	}
 	return nil
@@ -669,10 +1220,10 @@ func (v *mysqlValidator) validateConstraintGenerated() error {
 
 
 func (v *mysqlValidator) validateConstraintComment() error {
-	if err := v.validateToken(false, "COMMENT"); err != nil {
+	if err := v.validateToken(true, "COMMENT"); err != nil {
 		return err
 	}
-	if err := v.validateStringValue(false); err != nil {
+	if err := v.validateStringValue(true); err != nil {
 		return err
 	}
 	return nil
@@ -752,7 +1303,7 @@ func (v *mysqlValidator) validateTableConstraintAux() error {
 	if v.matchTokenNext(false, "FULLTEXT", "SPATIAL") {
 		v.matchTokenNext(false, "INDEX", "KEY")
 		if !v.matchToken("(") {
-			if err := v.validateName(true); err != nil {
+			if err := v.validateName(false); err != nil {
 				return err
 			}
 		}
@@ -796,8 +1347,8 @@ func (v *mysqlValidator) validateTableConstraintUnique() error {
 		return err
 	}
 	v.matchTokenNext(false, "INDEX", "KEY")
-	if !v.matchToken("(") {
-		if err := v.validateName(false); err != nil {
+	if !v.matchToken("(", "USING") {
+		if err := v.validateName(true); err != nil {
 			return err
 		}
 	}
@@ -981,13 +1532,16 @@ func (v *mysqlValidator) validateTableOption() error {
 	) {
 		return v.validateTableOptionCommonLiteral()
 	}
+	if v.matchToken("COMMENT") {
+		return v.validateTableOptionComment()
+	}
 	if v.matchToken(
-		"COMMENT", "ENGINE_ATTRIBUTE", "PASSWORD", "SECONDARY_ENGINE_ATTRIBUTE", "CONNECTION",
+		"ENGINE_ATTRIBUTE", "PASSWORD", "SECONDARY_ENGINE_ATTRIBUTE", "CONNECTION",
 		"COMPRESSION", "ENCRYPTION",
 	) {
 		return v.validateTableOptionCommonString()
 	}
-	if v.matchToken("COLLATE", "ENGINE", "CHARACTER") {
+	if v.matchToken("COLLATE", "ENGINE", "CHARACTER", "CHARSET") {
 		return v.validateTableOptionCommonName()
 	}
 	if v.matchToken("CHECKSUM", "DELAY_KEY_WRITE") {
@@ -1014,6 +1568,9 @@ func (v *mysqlValidator) validateTableOption() error {
 	if v.matchToken("ROW_FORMAT") {
 		return v.validateTableOptionRowFormat()
 	}
+	if v.matchToken("PARTITION") {
+		return v.validateTableOptionPartition()
+	}
 	
 	return v.syntaxError()
 }
@@ -1041,12 +1598,27 @@ func (v *mysqlValidator) validateTableOptionCommonString() error {
 }
 
 
+// COMMENT [=] 'string'
+func (v *mysqlValidator) validateTableOptionComment() error {
+	if err := v.validateToken(true, "COMMENT"); err != nil {
+		return err
+	}
+	v.matchTokenNext(false, "=")
+	if err := v.validateStringValue(true); err != nil {
+		return err
+	}
+	return nil
+}
+
+
 // option [=] name 
 func (v *mysqlValidator) validateTableOptionCommonName() error {
 	if v.matchTokenNext(false, "CHARACTER") {
 		if err := v.validateToken(false, "SET"); err != nil {
 			return err
 		}
+	} else if v.matchTokenNext(false, "CHARSET") {
+
 	} else {
 		if err := v.validateToken(false, "COLLATE", "ENGINE"); err != nil {
 			return err
@@ -1121,7 +1693,7 @@ func (v *mysqlValidator) validateTableOptionDefault() error {
 		if err := v.validateToken(false, "SET"); err != nil {
 			return err
 		}
-	} else if v.matchTokenNext(false, "COLLATE") {
+	} else if v.matchTokenNext(false, "COLLATE", "CHARSET") {
 
 	} else {
 		return v.syntaxError()
@@ -1134,6 +1706,21 @@ func (v *mysqlValidator) validateTableOptionDefault() error {
 }
 
 
+// PARTITION BY ... (partitioning is not kept.)
+func (v *mysqlValidator) validateTableOptionPartition() error {
+	if err := v.validateToken(false, "PARTITION"); err != nil {
+		return err
+	}
+	if err := v.validateToken(false, "BY"); err != nil {
+		return err
+	}
+	for !v.isOutOfRange() && !v.matchToken(";") {
+		v.next()
+	}
+	return nil
+}
+
+
 func (v *mysqlValidator) validateTableOptionUnion() error {
 	if err := v.validateToken(false, "UNION"); err != nil {
 		return err
@@ -1202,6 +1789,9 @@ var DataType_MySQL = []string{
 	"VARBINARY",
 	"BLOB",
 	"TEXT",
+	"TINYTEXT",
+	"MEDIUMTEXT",
+	"LONGTEXT",
 	//"ENUM",
 	//"SET",
 	"GEOMETRY",
@@ -29,9 +29,11 @@ func (v *postgresqlValidator) validate() error {
 	if (v.isOutOfRange()) {
 		return nil
 	}
+	begin := len(v.result)
 	if err := v.validateDdl(); err != nil {
 		return err
 	}
+	v.dropTrivia(begin)
 	return v.validate()
 }
 
@@ -110,11 +112,40 @@ func (v *postgresqlValidator) validateCommaSeparatedColumnNames(set bool) error
 
 
 func (v *postgresqlValidator) validateDdl() error {
+	if v.matchToken("ALTER") {
+		return v.validateAlter()
+	}
+	if v.matchToken("COMMENT") {
+		return v.validateComment()
+	}
+	if v.matchToken("SET") {
+		return v.validateSet()
+	}
+	if v.matchToken("DROP") {
+		return v.validateDrop()
+	}
+	if v.options.PgDump && v.matchToken("SELECT", "COPY", "GRANT", "REVOKE", "SECURITY") {
+		return v.validateDumpStatement()
+	}
 	if err := v.validateToken(false, "CREATE"); err != nil {
 		return err
 	}
+	persistence, err := v.validatePersistence()
+	if err != nil {
+		return err
+	}
 	if v.matchToken("TABLE") {
-		if err := v.validateCreateTable(); err != nil {
+		if err := v.validateCreateTable(persistence); err != nil {
+			return err
+		}
+	} else if persistence != "" && !v.matchToken("SEQUENCE", "VIEW") {
+		return v.syntaxError()
+	} else if v.matchToken("SEQUENCE") {
+		if err := v.validateCreateSequence(); err != nil {
+			return err
+		}
+	} else if v.matchToken("SCHEMA") {
+		if err := v.validateCreateSchema(); err != nil {
 			return err
 		}
 	} else {
@@ -126,8 +157,120 @@ func (v *postgresqlValidator) validateDdl() error {
 }
 
 
-func (v *postgresqlValidator) validateCreateTable() error {
+// SET [SESSION | LOCAL] configuration_parameter {TO | =} {value | 'value' | DEFAULT} [, ...];
+// Only search_path is kept.
+func (v *postgresqlValidator) validateSet() error {
+	if err := v.validateToken(false, "SET"); err != nil {
+		return err
+	}
+	v.matchTokenNext(false, "SESSION", "LOCAL")
+	if !v.matchToken("SEARCH_PATH") {
+		return v.validateSetOther()
+	}
+	v.set("SET")
+	v.set("SEARCH_PATH")
+	v.next()
+	if err := v.validateToken(false, "TO", "="); err != nil {
+		return err
+	}
+	v.set("TO")
+	if !v.matchTokenNext(true, "DEFAULT") {
+		if err := v.validateSearchPath(); err != nil {
+			return err
+		}
+	}
+	if err := v.validateToken(true, ";"); err != nil {
+		return err
+	}
+	return nil
+}
+
+
+func (v *postgresqlValidator) validateSearchPath() error {
+	if v.isStringValue(v.token()) {
+		v.set(v.next())
+	} else if err := v.validateName(true); err != nil {
+		return err
+	}
+	if v.matchTokenNext(true, ",") {
+		return v.validateSearchPath()
+	}
+	return nil
+}
+
+
+func (v *postgresqlValidator) validateSetOther() error {
+	for !v.matchToken(";") {
+		if v.isOutOfRange() {
+			return v.syntaxError()
+		}
+		v.next()
+	}
+	if err := v.validateToken(false, ";"); err != nil {
+		return err
+	}
+	return nil
+}
+
+
+// CREATE SCHEMA [IF NOT EXISTS] schema_name [AUTHORIZATION role_specification];
+// CREATE SCHEMA [IF NOT EXISTS] AUTHORIZATION role_specification;
+// (schema elements are skipped.)
+func (v *postgresqlValidator) validateCreateSchema() error {
 	v.set("CREATE")
+	if err := v.validateToken(true, "SCHEMA"); err != nil {
+		return err
+	}
+	if err := v.validateIfNotExists(); err != nil {
+		return err
+	}
+	if !v.matchToken("AUTHORIZATION") {
+		if err := v.validateName(true); err != nil {
+			return err
+		}
+	}
+	if v.matchTokenNext(true, "AUTHORIZATION") {
+		if v.matchToken("CURRENT_ROLE", "CURRENT_USER", "SESSION_USER") {
+			v.set(v.next())
+		} else if err := v.validateName(true); err != nil {
+			return err
+		}
+	}
+	for !v.matchToken(";") {
+		if v.isOutOfRange() {
+			return v.syntaxError()
+		}
+		v.next()
+	}
+	if err := v.validateToken(true, ";"); err != nil {
+		return err
+	}
+	return nil
+}
+
+
+// [GLOBAL | LOCAL] {TEMPORARY | TEMP} | UNLOGGED
+func (v *postgresqlValidator) validatePersistence() (string, error) {
+	if v.matchTokenNext(false, "GLOBAL", "LOCAL") {
+		if !v.matchToken("TEMPORARY", "TEMP") {
+			return "", v.syntaxError()
+		}
+	}
+	if v.matchTokenNext(false, "TEMPORARY", "TEMP") {
+		return "TEMPORARY", nil
+	}
+	if v.matchTokenNext(false, "UNLOGGED") {
+		return "UNLOGGED", nil
+	}
+	return "", nil
+}
+
+
+func (v *postgresqlValidator) validateCreateTable(persistence string) error {
+	v.set("CREATE")
+	if persistence != "" {
+		v.set(persistence)
+	}
 	if err := v.validateToken(true, "TABLE"); err != nil {
 		return err
 	}
@@ -137,7 +280,12 @@ func (v *postgresqlValidator) validateCreateTable() error {
 	if err := v.validateTableName(true); err != nil {
 		return err
 	}
-	if err := v.validateTableDefinition(); err != nil {
+	temporary := persistence == "TEMPORARY"
+	if v.isCreateTableAs() {
+		if err := v.validateCreateTableAs(temporary); err != nil {
+			return err
+		}
+	} else if err := v.validateTableDefinition(temporary); err != nil {
 		return err
 	}
 	if err := v.validateToken(true, ";"); err != nil {
@@ -147,9 +295,469 @@ func (v *postgresqlValidator) validateCreateTable() error {
 }
 
 
+/*
+  [(column_name, ...)] [USING method] [WITH (storage_parameter, ...) | WITHOUT OIDS]
+  [ON COMMIT {PRESERVE ROWS | DELETE ROWS | DROP}] [TABLESPACE tablespace_name]
+  AS query [WITH [NO] DATA]
+*/
+func (v *postgresqlValidator) validateCreateTableAs(temporary bool) error {
+	if v.matchTokenNext(true, "(") {
+		if err := v.validateCommaSeparatedColumnNames(true); err != nil {
+			return err
+		}
+		if err := v.validateToken(true, ")"); err != nil {
+			return err
+		}
+	}
+	for v.matchToken("USING", "WITH", "WITHOUT", "ON", "TABLESPACE") {
+		if err := v.validateTableOption(temporary); err != nil {
+			return err
+		}
+	}
+	if err := v.validateToken(true, "AS"); err != nil {
+		return err
+	}
+	if err := v.validateQuery(); err != nil {
+		return err
+	}
+	if v.matchTokenNext(false, "WITH") {
+		v.matchTokenNext(false, "NO")
+		if err := v.validateToken(false, "DATA"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+
+func (v *postgresqlValidator) validateCreateSequence() error {
+	v.set("CREATE")
+	if err := v.validateToken(true, "SEQUENCE"); err != nil {
+		return err
+	}
+	if err := v.validateIfNotExists(); err != nil {
+		return err
+	}
+	if err := v.validateTableName(true); err != nil {
+		return err
+	}
+	if err := v.validateCreateSequenceOptions(); err != nil {
+		return err
+	}
+	if err := v.validateToken(true, ";"); err != nil {
+		return err
+	}
+	return nil
+}
+
+
+func (v *postgresqlValidator) validateCreateSequenceOptions() error {
+	if err := v.validateSequenceOptions(); err != nil {
+		return err
+	}
+	if v.matchToken("OWNED") {
+		if err := v.validateSequenceOwnedBy(); err != nil {
+			return err
+		}
+		return v.validateCreateSequenceOptions()
+	}
+	return nil
+}
+
+
+// PgDump: SELECT pg_catalog.set_config(...), COPY ... FROM stdin (the data is skipped by the lexer),
+// GRANT, REVOKE and SECURITY LABEL are not kept.
+func (v *postgresqlValidator) validateDumpStatement() error {
+	for !v.matchToken(";") {
+		if v.isOutOfRange() {
+			return v.syntaxError()
+		}
+		v.next()
+	}
+	if err := v.validateToken(false, ";"); err != nil {
+		return err
+	}
+	return nil
+}
+
+
+func (v *postgresqlValidator) validateAlter() error {
+	if err := v.validateToken(false, "ALTER"); err != nil {
+		return err
+	}
+	if v.matchToken("SEQUENCE") {
+		return v.validateAlterSequence()
+	}
+	if v.matchToken("TABLE") {
+		return v.validateAlterTable()
+	}
+	return v.validateAlterOther()
+}
+
+
+// ALTER object ...; (the objects other than tables and sequences are not kept.)
+func (v *postgresqlValidator) validateAlterOther() error {
+	if err := v.validateToken(false, 
+		"SCHEMA", "VIEW", "MATERIALIZED", "INDEX", "FUNCTION", "PROCEDURE", "ROUTINE",
+		"TYPE", "DOMAIN", "AGGREGATE", "EXTENSION", "ROLE", "USER", "GROUP", "DEFAULT",
+		"TABLESPACE", "DATABASE", "LANGUAGE", "FOREIGN", "SERVER", "CONVERSION", "RULE",
+		"COLLATION", "POLICY", "OPERATOR", "EVENT", "PUBLICATION", "SUBSCRIPTION",
+		"STATISTICS", "TEXT", "TRIGGER", "LARGE",
+	); err != nil {
+		return err
+	}
+	for !v.matchToken(";") {
+		if v.isOutOfRange() {
+			return v.syntaxError()
+		}
+		v.next()
+	}
+	if err := v.validateToken(false, ";"); err != nil {
+		return err
+	}
+	return nil
+}
+
+
+/*
+  ALTER TABLE [IF EXISTS] [ONLY] name [*] action [, ...];
+  The actions kept are:
+    ADD [CONSTRAINT constraint_name] table_constraint [NOT VALID]
+    ALTER [COLUMN] column_name {SET DEFAULT expr | DROP DEFAULT | {SET | DROP} NOT NULL}
+    ALTER [COLUMN] column_name ADD GENERATED {ALWAYS | BY DEFAULT} AS IDENTITY [(sequence_options)]
+  The actions that do not change the columns and constraints (OWNER TO, CLUSTER ON, ...) are skipped.
+*/
+func (v *postgresqlValidator) validateAlterTable() error {
+	v.set("ALTER")
+	if err := v.validateToken(true, "TABLE"); err != nil {
+		return err
+	}
+	if v.matchTokenNext(false, "IF") {
+		if err := v.validateToken(false, "EXISTS"); err != nil {
+			return err
+		}
+	}
+	v.matchTokenNext(false, "ONLY")
+	if err := v.validateTableName(true); err != nil {
+		return err
+	}
+	v.matchTokenNext(false, "*")
+	if err := v.validateAlterTableActions(false); err != nil {
+		return err
+	}
+	if err := v.validateToken(true, ";"); err != nil {
+		return err
+	}
+	return nil
+}
+
+
+// set: an action is set before, so the comma is set before the next one that is.
+func (v *postgresqlValidator) validateAlterTableActions(set bool) error {
+	if v.isAlterTableActionKept() {
+		if set {
+			v.set(",")
+		}
+		if err := v.validateAlterTableAction(); err != nil {
+			return err
+		}
+		set = true
+	} else if err := v.validateAlterTableActionOther(); err != nil {
+		return err
+	}
+	if v.matchTokenNext(false, ",") {
+		return v.validateAlterTableActions(set)
+	}
+	return nil
+}
+
+
+func (v *postgresqlValidator) isAlterTableActionKept() bool {
+	if v.matchToken("ADD") {
+		return !v.isAddColumn()
+	}
+	return v.matchToken("ALTER") && v.isAlterColumnKept()
+}
+
+
+// ADD [COLUMN] column_name ... (not supported)
+func (v *postgresqlValidator) isAddColumn() bool {
+	next := strings.ToUpper(v.peek())
+	return next != "CONSTRAINT" && next != "PRIMARY" && next != "UNIQUE" && 
+		next != "CHECK" && next != "FOREIGN" && next != "EXCLUDE"
+}
+
+
+// ALTER [COLUMN] column_name {SET DEFAULT | DROP DEFAULT | SET NOT NULL | DROP NOT NULL | ADD GENERATED}
+func (v *postgresqlValidator) isAlterColumnKept() bool {
+	tokens := []string{}
+	for i := v.i + 1; i < v.size && len(tokens) < 4; i++ {
+		if !v.isTrivia(v.tokens[i]) {
+			tokens = append(tokens, strings.ToUpper(v.tokens[i]))
+		}
+	}
+	if len(tokens) > 0 && tokens[0] == "COLUMN" {
+		tokens = tokens[1:]
+	}
+	if len(tokens) < 3 {
+		return false
+	}
+	if tokens[1] == "SET" || tokens[1] == "DROP" {
+		return tokens[2] == "DEFAULT" || tokens[2] == "NOT"
+	}
+	return tokens[1] == "ADD"
+}
+
+
+func (v *postgresqlValidator) validateAlterTableAction() error {
+	if v.matchTokenNext(true, "ADD") {
+		if err := v.validateTableConstraint(); err != nil {
+			return err
+		}
+		if v.matchTokenNext(false, "NOT") {
+			if err := v.validateToken(false, "VALID"); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := v.validateToken(true, "ALTER"); err != nil {
+		return err
+	}
+	v.matchTokenNext(false, "COLUMN")
+	v.set("COLUMN")
+	if err := v.validateColumnName(true); err != nil {
+		return err
+	}
+	if v.matchTokenNext(true, "ADD") {
+		if err := v.validateToken(true, "GENERATED"); err != nil {
+			return err
+		}
+		if v.matchTokenNext(true, "BY") {
+			if err := v.validateToken(true, "DEFAULT"); err != nil {
+				return err
+			}
+		} else if err := v.validateToken(true, "ALWAYS"); err != nil {
+			return err
+		}
+		if err := v.validateToken(true, "AS"); err != nil {
+			return err
+		}
+		return v.validateIdentity()
+	}
+	if v.matchTokenNext(true, "DROP") {
+		if v.matchToken("NOT") {
+			return v.validateConstraintNotNull()
+		}
+		return v.validateToken(true, "DEFAULT")
+	}
+	if err := v.validateToken(true, "SET"); err != nil {
+		return err
+	}
+	if v.matchToken("NOT") {
+		return v.validateConstraintNotNull()
+	}
+	return v.validateConstraintDefault()
+}
+
+
+// The actions that are not kept.
+// RENAME, DROP COLUMN, ALTER COLUMN TYPE, ADD COLUMN, DROP CONSTRAINT, ... are not supported.
+func (v *postgresqlValidator) validateAlterTableActionOther() error {
+	if v.matchTokenNext(false, "ALTER") {
+		v.matchTokenNext(false, "COLUMN")
+		if err := v.validateColumnName(false); err != nil {
+			return err
+		}
+		if v.matchTokenNext(false, "SET", "RESET", "OPTIONS") {
+			if !v.matchToken("STATISTICS", "STORAGE", "COMPRESSION", "(") {
+				return v.syntaxError()
+			}
+		} else {
+			return v.syntaxError()
+		}
+	} else if v.matchToken("SET") && strings.ToUpper(v.peek()) == "SCHEMA" {
+		return v.syntaxError()
+	} else if !v.matchToken(
+		"OWNER", "CLUSTER", "SET", "RESET", "REPLICA", "ENABLE", "DISABLE", "FORCE", 
+		"NO", "INHERIT", "ATTACH", "DETACH", "VALIDATE", "OF", "NOT",
+	) {
+		return v.syntaxError()
+	}
+	for !v.matchToken(",", ";") {
+		if v.isOutOfRange() {
+			return v.syntaxError()
+		}
+		if v.matchToken("(") {
+			if err := v.validateBrackets(false); err != nil {
+				return err
+			}
+		} else {
+			v.next()
+		}
+	}
+	return nil
+}
+
+
+// COMMENT ON {TABLE table_name | COLUMN table_name.column_name | object ...} IS {'text' | NULL}
+func (v *postgresqlValidator) validateComment() error {
+	if err := v.validateToken(false, "COMMENT"); err != nil {
+		return err
+	}
+	if err := v.validateToken(false, "ON"); err != nil {
+		return err
+	}
+	if !v.matchToken("TABLE", "COLUMN") {
+		return v.validateCommentOther()
+	}
+	v.set("COMMENT")
+	v.set("ON")
+	isColumn := v.matchToken("COLUMN")
+	v.set(v.next())
+	if err := v.validateName(true); err != nil {
+		return err
+	}
+	if isColumn {
+		if err := v.validateToken(true, "."); err != nil {
+			return err
+		}
+		if err := v.validateName(true); err != nil {
+			return err
+		}
+	}
+	if v.matchTokenNext(true, ".") {
+		if err := v.validateName(true); err != nil {
+			return err
+		}
+	}
+	if err := v.validateToken(true, "IS"); err != nil {
+		return err
+	}
+	if v.isStringValue(v.token()) {
+		v.set(v.next())
+	} else if err := v.validateToken(true, "NULL"); err != nil {
+		return err
+	}
+	if err := v.validateToken(true, ";"); err != nil {
+		return err
+	}
+	return nil
+}
+
+
+func (v *postgresqlValidator) validateCommentOther() error {
+	for !v.matchToken("IS") {
+		if v.isOutOfRange() || v.matchToken(";") {
+			return v.syntaxError()
+		}
+		v.next()
+	}
+	if err := v.validateToken(false, "IS"); err != nil {
+		return err
+	}
+	if v.isStringValue(v.token()) {
+		v.next()
+	} else if err := v.validateToken(false, "NULL"); err != nil {
+		return err
+	}
+	if err := v.validateToken(false, ";"); err != nil {
+		return err
+	}
+	return nil
+}
+
+
+func (v *postgresqlValidator) validateAlterSequence() error {
+	v.set("ALTER")
+	if err := v.validateToken(true, "SEQUENCE"); err != nil {
+		return err
+	}
+	if v.matchTokenNext(false, "IF") {
+		if err := v.validateToken(false, "EXISTS"); err != nil {
+			return err
+		}
+	}
+	if err := v.validateTableName(true); err != nil {
+		return err
+	}
+	if err := v.validateAlterSequenceOptions(); err != nil {
+		return err
+	}
+	if err := v.validateToken(true, ";"); err != nil {
+		return err
+	}
+	return nil
+}
+
+
+func (v *postgresqlValidator) validateAlterSequenceOptions() error {
+	if err := v.validateSequenceOptions(); err != nil {
+		return err
+	}
+	if v.matchToken("OWNED") {
+		if err := v.validateSequenceOwnedBy(); err != nil {
+			return err
+		}
+		return v.validateAlterSequenceOptions()
+	}
+	if v.matchTokenNext(false, "OWNER") {
+		if err := v.validateToken(false, "TO"); err != nil {
+			return err
+		}
+		if err := v.validateName(false); err != nil {
+			return err
+		}
+		return v.validateAlterSequenceOptions()
+	}
+	if v.matchTokenNext(false, "RESTART") {
+		if v.matchTokenNext(false, "WITH") {
+			if !common.IsIntegerToken(v.token()) {
+				return v.syntaxError()
+			}
+			v.next()
+		} else if common.IsIntegerToken(v.token()) {
+			v.next()
+		}
+		return v.validateAlterSequenceOptions()
+	}
+	return nil
+}
+
+
+// OWNED BY {[schema_name.]table_name.column_name | NONE}
+func (v *postgresqlValidator) validateSequenceOwnedBy() error {
+	if err := v.validateToken(true, "OWNED"); err != nil {
+		return err
+	}
+	if err := v.validateToken(true, "BY"); err != nil {
+		return err
+	}
+	if v.matchTokenNext(true, "NONE") {
+		return nil
+	}
+	// [schema_name.]table_name.column_name
+	if err := v.validateName(true); err != nil {
+		return err
+	}
+	if err := v.validateToken(true, "."); err != nil {
+		return err
+	}
+	if err := v.validateName(true); err != nil {
+		return err
+	}
+	if v.matchTokenNext(true, ".") {
+		if err := v.validateColumnName(true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+
 func (v *postgresqlValidator) validateCreateOther() error {
 	if err := v.validateToken(false, 
-		"VIEW", "TRIGGER", "INDEX", "MATERIALIZED", "SEQUENCE", "FUNCTION", "TYPE",
+		"VIEW", "TRIGGER", "INDEX", "UNIQUE", "MATERIALIZED", "SEQUENCE", "FUNCTION", "TYPE",
 		"PROCEDURE", "TYPE", "AGGREGATE", "SCHEMA", "ROLE", "USER", "GROUP",
 		"TABLESPACE", "EXTENSION", "DATABASE", "LANGUAGE", "FOREIGN", "DOMAIN",
 		"SERVER", "FOREIGN", "CONVERSION", "RULE", "COLLATION", "POLICY", "OPERATOR",
@@ -182,6 +790,83 @@ func (v *postgresqlValidator) validateCreateOther() error {
 }
 
 
+// DROP {TABLE | SEQUENCE | SCHEMA} [IF EXISTS] name [, ...] [CASCADE | RESTRICT];
+// DROP object ...; (the other objects are not kept.)
+func (v *postgresqlValidator) validateDrop() error {
+	if err := v.validateToken(false, "DROP"); err != nil {
+		return err
+	}
+	if !v.matchToken("TABLE", "SEQUENCE", "SCHEMA") {
+		return v.validateDropOther()
+	}
+	v.set("DROP")
+	isSchema := v.matchToken("SCHEMA")
+	v.set(v.next())
+	if v.matchTokenNext(false, "IF") {
+		if err := v.validateToken(false, "EXISTS"); err != nil {
+			return err
+		}
+	}
+	if isSchema {
+		if err := v.validateCommaSeparatedNames(true); err != nil {
+			return err
+		}
+	} else if err := v.validateCommaSeparatedTableNames(true); err != nil {
+		return err
+	}
+	v.matchTokenNext(true, "CASCADE", "RESTRICT")
+	if err := v.validateToken(true, ";"); err != nil {
+		return err
+	}
+	return nil
+}
+
+
+func (v *postgresqlValidator) validateDropOther() error {
+	if err := v.validateToken(false, 
+		"INDEX", "VIEW", "MATERIALIZED", "TRIGGER", "FUNCTION", "PROCEDURE", "ROUTINE",
+		"TYPE", "DOMAIN", "AGGREGATE", "EXTENSION", "ROLE", "USER", "GROUP", "OWNED",
+		"TABLESPACE", "DATABASE", "LANGUAGE", "FOREIGN", "SERVER", "CONVERSION", "RULE",
+		"COLLATION", "POLICY", "OPERATOR", "EVENT", "PUBLICATION", "SUBSCRIPTION",
+		"STATISTICS", "TEXT", "CAST", "ACCESS", "TRANSFORM",
+	); err != nil {
+		return err
+	}
+	for !v.matchToken(";") {
+		if v.isOutOfRange() {
+			return v.syntaxError()
+		}
+		v.next()
+	}
+	if err := v.validateToken(false, ";"); err != nil {
+		return err
+	}
+	return nil
+}
+
+
+func (v *postgresqlValidator) validateCommaSeparatedNames(set bool) error {
+	if err := v.validateName(set); err != nil {
+		return err
+	}
+	if v.matchTokenNext(set, ",") {
+		return v.validateCommaSeparatedNames(set)
+	}
+	return nil
+}
+
+
+func (v *postgresqlValidator) validateCommaSeparatedTableNames(set bool) error {
+	if err := v.validateTableName(set); err != nil {
+		return err
+	}
+	if v.matchTokenNext(set, ",") {
+		return v.validateCommaSeparatedTableNames(set)
+	}
+	return nil
+}
+
+
 func (v *postgresqlValidator) validateIfNotExists() error {
 	if v.matchTokenNext(true, "IF") {
 		if err := v.validateToken(true, "NOT"); err != nil {
@@ -195,7 +880,7 @@ func (v *postgresqlValidator) validateIfNotExists() error {
 }
 
 
-func (v *postgresqlValidator) validateTableDefinition() error {
+func (v *postgresqlValidator) validateTableDefinition(temporary bool) error {
 	if err := v.validateToken(true, "("); err != nil {
 		return err
 	}
@@ -205,7 +890,7 @@ func (v *postgresqlValidator) validateTableDefinition() error {
 	if err := v.validateToken(true, ")"); err != nil {
 		return err
 	}
-	if err := v.validateTableOptions(); err != nil {
+	if err := v.validateTableOptions(temporary); err != nil {
 		return err
 	}
 	return nil
@@ -227,20 +912,55 @@ func (v *postgresqlValidator) validateColumnDefinition() error {
 	if v.matchToken("CONSTRAINT", "PRIMARY", "UNIQUE", "CHECK", "FOREIGN", "EXCLUDE") {
 		return v.validateTableConstraint()
 	}
+	if v.matchToken("LIKE") {
+		return v.validateLikeClause()
+	}
 	if err := v.validateColumnName(true); err != nil {
 		return err
 	}
-	if err := v.validateColumnType(); err != nil {
+	if err := v.validateColumnType(); err != nil {
+		return err
+	}
+	for strings.HasPrefix(v.token(), "[") {
+		v.set(v.next())
+	}
+	if err := v.validateColumnConstraints(); err != nil {
+		return err
+	}
+	return nil
+}
+
+
+// LIKE source_table [{INCLUDING | EXCLUDING} like_option ...]
+func (v *postgresqlValidator) validateLikeClause() error {
+	if err := v.validateToken(true, "LIKE"); err != nil {
+		return err
+	}
+	if err := v.validateTableName(true); err != nil {
 		return err
 	}
-	if err := v.validateColumnConstraints(); err != nil {
-		return err
+	for v.matchTokenNext(true, "INCLUDING", "EXCLUDING") {
+		if err := v.validateToken(true,
+			"COMMENTS", "COMPRESSION", "CONSTRAINTS", "DEFAULTS", "GENERATED",
+			"IDENTITY", "INDEXES", "STATISTICS", "STORAGE", "ALL",
+		); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
 
 func (v *postgresqlValidator) validateColumnType() error {
+	// a user-defined type (enum, domain, ...) with the schema: public.mood
+	if v.peek() == "." {
+		if err := v.validateName(true); err != nil {
+			return err
+		}
+		v.set(v.next())
+		return v.validateName(true)
+	}
+
 	if v.matchTokenNext(true, "BIT", "CHARACTER") {
 		v.matchTokenNext(true, "VARYING")
 		if err := v.validateTypeDigitN(); err != nil {
@@ -270,9 +990,20 @@ func (v *postgresqlValidator) validateColumnType() error {
 		return nil
 	}
 
-	// TODO
-	//if v.matchToken("INTERVAL") {
-	//}
+	// INTERVAL [fields] [(p)] (the fields are not kept.)
+	if v.matchTokenNext(true, "INTERVAL") {
+		if v.matchTokenNext(false, "YEAR", "MONTH", "DAY", "HOUR", "MINUTE", "SECOND") {
+			if v.matchTokenNext(false, "TO") {
+				if err := v.validateToken(false, "MONTH", "HOUR", "MINUTE", "SECOND"); err != nil {
+					return err
+				}
+			}
+		}
+		if err := v.validateTypeDigitP(); err != nil {
+			return err
+		}
+		return nil
+	}
 
 	if v.matchTokenNext(true, "TIME", "TIMESTAMP") {
 		if err := v.validateTypeDigitP(); err != nil {
@@ -343,24 +1074,36 @@ func (v *postgresqlValidator) validatePositiveInteger() error {
 
 
 func (v *postgresqlValidator) validateColumnConstraints() error {
+	return v.validateColumnConstraintsAux([]string{})
+}
+
+
+// [CONSTRAINT name] column-constraint
+func (v *postgresqlValidator) validateConstraintName() error {
 	if v.matchTokenNext(true, "CONSTRAINT") {
 		if err := v.validateName(true); err != nil {
 			return err
 		}
+		if !v.isColumnConstraint(v.token()) {
+			return v.syntaxError()
+		}
 	}
-	return v.validateColumnConstraintsAux([]string{})
+	return nil
 }
 
 
 func (v *postgresqlValidator) isColumnConstraint(token string) bool {
 	return v.matchToken(
 		"PRIMARY", "NOT", "NULL", "UNIQUE", "CHECK", 
-		"DEFAULT", "REFERENCES", "GENERATED", "AS",
+		"DEFAULT", "REFERENCES", "GENERATED", "AS", "COLLATE",
 	)
 }
 
 
 func (v *postgresqlValidator) validateColumnConstraintsAux(ls []string) error {
+	if err := v.validateConstraintName(); err != nil {
+		return err
+	}
 	if !v.isColumnConstraint(v.token()) {
 		return nil
 	} 
@@ -408,10 +1151,30 @@ func (v *postgresqlValidator) validateColumnConstraint() error {
 	if v.matchToken("GENERATED", "AS") {
 		return v.validateConstraintGenerated()
 	}
+	if v.matchToken("COLLATE") {
+		return v.validateConstraintCollate()
+	}
 	return v.syntaxError()
 }
 
 
+// COLLATE [schema_name.]collation_name
+func (v *postgresqlValidator) validateConstraintCollate() error {
+	if err := v.validateToken(true, "COLLATE"); err != nil {
+		return err
+	}
+	if err := v.validateName(true); err != nil {
+		return err
+	}
+	if v.matchTokenNext(true, ".") {
+		if err := v.validateName(true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+
 func (v *postgresqlValidator) validateConstraintPrimaryKey() error {
 	if err := v.validateToken(true, "PRIMARY"); err != nil {
 		return err
@@ -419,7 +1182,7 @@ func (v *postgresqlValidator) validateConstraintPrimaryKey() error {
 	if err := v.validateToken(true, "KEY"); err != nil {
 		return err
 	}
-	if err := v.validateIndexParameters(); err != nil {
+	if err := v.validateIndexParameters(true); err != nil {
 		return err
 	}
 	return nil
@@ -449,7 +1212,10 @@ func (v *postgresqlValidator) validateConstraintUnique() error {
 	if err := v.validateToken(true, "UNIQUE"); err != nil {
 		return err
 	}
-	if err := v.validateIndexParameters(); err != nil {
+	if err := v.validateNullsDistinct(); err != nil {
+		return err
+	}
+	if err := v.validateIndexParameters(true); err != nil {
 		return err
 	}
 	return nil
@@ -480,10 +1246,63 @@ func (v *postgresqlValidator) validateConstraintDefault() error {
 		if err := v.validateExpr(true); err != nil {
 			return err
 		}
+	} else if v.peek() == "(" || v.peek() == "." {
+		if err := v.validateFunction(); err != nil {
+			return err
+		}
 	} else {
 		if err := v.validateLiteralValue(); err != nil {
 			return err
 		}
+		for v.matchTokenNext(true, "::") {
+			if err := v.validateCastType(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+
+// the type after "::": [schema_name.]name [words] [(n [, m])] [[]] ('active'::character varying)
+func (v *postgresqlValidator) validateCastType() error {
+	if err := v.validateName(true); err != nil {
+		return err
+	}
+	if v.matchTokenNext(true, ".") {
+		if err := v.validateName(true); err != nil {
+			return err
+		}
+	}
+	for v.matchTokenNext(true, "PRECISION", "VARYING", "WITH", "WITHOUT", "TIME", "ZONE") {
+	}
+	if v.matchToken("(") {
+		if err := v.validateBrackets(true); err != nil {
+			return err
+		}
+	}
+	for strings.HasPrefix(v.token(), "[") {
+		v.set(v.next())
+	}
+	return nil
+}
+
+
+// [schema_name.]function_name (...)
+func (v *postgresqlValidator) validateFunction() error {
+	pattern := regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+	if v.peek() == "." {
+		if err := v.validateName(true); err != nil {
+			return err
+		}
+		v.set(v.next())
+	}
+	if !pattern.MatchString(v.token()) {
+		return v.syntaxError()
+	}
+	v.set(v.next())
+	if err := v.validateBrackets(true); err != nil {
+		return err
 	}
 	return nil
 }
@@ -556,6 +1375,21 @@ func (v *postgresqlValidator) validateConstraintReferencesAux() error {
 		return v.validateConstraintReferencesAux()
 	}
 
+	return v.validateDeferrable()
+}
+
+
+// [NOT] DEFERRABLE [INITIALLY {DEFERRED | IMMEDIATE}] (not kept.)
+func (v *postgresqlValidator) validateDeferrable() error {
+	if v.matchToken("NOT") && strings.ToUpper(v.peek()) == "DEFERRABLE" {
+		v.next()
+	}
+	v.matchTokenNext(false, "DEFERRABLE")
+	if v.matchTokenNext(false, "INITIALLY") {
+		if err := v.validateToken(false, "DEFERRED", "IMMEDIATE"); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -569,19 +1403,20 @@ func (v *postgresqlValidator) validateConstraintGenerated() error {
 		if err := v.validateToken(false, "AS"); err != nil {
 			return err
 		}
-		if v.matchTokenNext(false, "IDENTITY") {
-			if v.matchToken("(") {
-				if err := v.validateBrackets(false); err != nil {
-					return err
-				}
-			}
-			return nil
+		if v.matchToken("IDENTITY") {
+			v.set("GENERATED")
+			v.set("ALWAYS")
+			v.set("AS")
+			return v.validateIdentity()
 
 		} else if v.matchToken("(") {
-			if err := v.validateBrackets(false); err != nil {
+			v.set("GENERATED")
+			v.set("ALWAYS")
+			v.set("AS")
+			if err := v.validateBrackets(true); err != nil {
 				return err
 			}
-			if err := v.validateToken(false, "STORED"); err != nil {
+			if err := v.validateToken(true, "STORED"); err != nil {
 				return err
 			}
 			return nil
@@ -596,57 +1431,169 @@ func (v *postgresqlValidator) validateConstraintGenerated() error {
 		if err := v.validateToken(false, "AS"); err != nil {
 			return err
 		}
-		if err := v.validateToken(false, "IDENTITY"); err != nil {
+		v.set("GENERATED")
+		v.set("BY")
+		v.set("DEFAULT")
+		v.set("AS")
+		return v.validateIdentity()
+
+	} else if v.matchTokenNext(false, "AS") {
+		v.set("GENERATED")
+		v.set("AS")
+		return v.validateIdentity()
+	}
+
+	return v.syntaxError()
+}
+
+
+func (v *postgresqlValidator) validateIdentity() error {
+	if err := v.validateToken(true, "IDENTITY"); err != nil {
+		return err
+	}
+	if v.matchTokenNext(true, "(") {
+		if err := v.validateSequenceOptions(); err != nil {
 			return err
 		}
-		if v.matchToken("(") {
-			if err := v.validateBrackets(false); err != nil {
-				return err
-			}
+		if err := v.validateToken(true, ")"); err != nil {
+			return err
 		}
-		return nil
+	}
+	return nil
+}
 
-	} else if v.matchTokenNext(false, "AS") {
-		if err := v.validateToken(false, "IDENTITY"); err != nil {
+
+func (v *postgresqlValidator) validateSequenceOptions() error {
+	if v.matchTokenNext(true, "AS") {
+		if err := v.validateToken(true, "SMALLINT", "INTEGER", "INT", "BIGINT", "INT2", "INT4", "INT8"); err != nil {
 			return err
 		}
-		if v.matchToken("(") {
-			if err := v.validateBrackets(false); err != nil {
-				return err
-			}
+		return v.validateSequenceOptions()
+	}
+	if v.matchTokenNext(true, "INCREMENT") {
+		v.matchTokenNext(false, "BY")
+		if err := v.validateInteger(); err != nil {
+			return err
 		}
-		return nil
+		return v.validateSequenceOptions()
+	}
+	if v.matchTokenNext(true, "START") {
+		v.matchTokenNext(false, "WITH")
+		if err := v.validateInteger(); err != nil {
+			return err
+		}
+		return v.validateSequenceOptions()
 	}
+	if v.matchTokenNext(true, "MINVALUE", "MAXVALUE", "CACHE") {
+		if err := v.validateInteger(); err != nil {
+			return err
+		}
+		return v.validateSequenceOptions()
+	}
+	if v.matchTokenNext(true, "NO") {
+		if err := v.validateToken(true, "MINVALUE", "MAXVALUE", "CYCLE"); err != nil {
+			return err
+		}
+		return v.validateSequenceOptions()
+	}
+	if v.matchTokenNext(true, "CYCLE") {
+		return v.validateSequenceOptions()
+	}
+	if v.matchTokenNext(false, "SEQUENCE") {
+		if err := v.validateToken(false, "NAME"); err != nil {
+			return err
+		}
+		if err := v.validateTableName(false); err != nil {
+			return err
+		}
+		return v.validateSequenceOptions()
+	}
+	return nil
+}
 
-	return v.syntaxError()
+
+func (v *postgresqlValidator) validateInteger() error {
+	if !common.IsIntegerToken(v.token()) {
+		return v.syntaxError()
+	}
+	v.set(v.next())
+	return nil
 }
 
 
-func (v *postgresqlValidator) validateIndexParameters() error {
-	if v.matchTokenNext(false, "INCLUDE") {
-		if err := v.validateToken(false, "("); err != nil {
+func (v *postgresqlValidator) validateIndexParameters(set bool) error {
+	if v.matchTokenNext(set, "INCLUDE") {
+		if err := v.validateToken(set, "("); err != nil {
 			return err
 		}
-		if err := v.validateCommaSeparatedColumnNames(false); err != nil {
+		if err := v.validateCommaSeparatedColumnNames(set); err != nil {
 			return err
 		}
-		if err := v.validateToken(false, ")"); err != nil {
+		if err := v.validateToken(set, ")"); err != nil {
 			return err
 		}
 	}
-	if v.matchTokenNext(false, "WITH") {
-		if err := v.validateBrackets(false); err != nil {
+	if v.matchTokenNext(set, "WITH") {
+		if err := v.validateStorageParameters(set); err != nil {
 			return err
 		}
 	}
-	if v.matchTokenNext(false, "USING") {
-		if err := v.validateToken(false, "INDEX"); err != nil {
+	if v.matchTokenNext(set, "USING") {
+		if err := v.validateToken(set, "INDEX"); err != nil {
 			return err
 		}
-		if err := v.validateToken(false, "TABLESPACE"); err != nil {
+		if err := v.validateToken(set, "TABLESPACE"); err != nil {
 			return err
 		}
-		if err := v.validateName(false); err != nil {
+		if err := v.validateName(set); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+
+// ( storage_parameter [= value] [, ... ] )
+func (v *postgresqlValidator) validateStorageParameters(set bool) error {
+	if err := v.validateToken(set, "("); err != nil {
+		return err
+	}
+	if err := v.validateStorageParametersAux(set); err != nil {
+		return err
+	}
+	if err := v.validateToken(set, ")"); err != nil {
+		return err
+	}
+	return nil
+}
+
+
+func (v *postgresqlValidator) validateStorageParametersAux(set bool) error {
+	if v.matchToken(",", ")", "(") {
+		return v.syntaxError()
+	}
+	for !v.matchToken(",", ")") {
+		if v.isOutOfRange() || v.matchToken("(") {
+			return v.syntaxError()
+		}
+		if set {
+			v.set(v.next())
+		} else {
+			v.next()
+		}
+	}
+	if v.matchTokenNext(set, ",") {
+		return v.validateStorageParametersAux(set)
+	}
+	return nil
+}
+
+
+// NULLS [NOT] DISTINCT
+func (v *postgresqlValidator) validateNullsDistinct() error {
+	if v.matchTokenNext(true, "NULLS") {
+		v.matchTokenNext(true, "NOT")
+		if err := v.validateToken(true, "DISTINCT"); err != nil {
 			return err
 		}
 	}
@@ -660,6 +1607,14 @@ func (v *postgresqlValidator) validateTableConstraint() error {
 			return err
 		}
 	}
+	if err := v.validateTableConstraintAux(); err != nil {
+		return err
+	}
+	return v.validateDeferrable()
+}
+
+
+func (v *postgresqlValidator) validateTableConstraintAux() error {
 	if v.matchToken("PRIMARY") {
 		return v.validateTableConstraintPrimaryKey()
 	}
@@ -696,7 +1651,7 @@ func (v *postgresqlValidator) validateTableConstraintPrimaryKey() error {
 	if err := v.validateToken(true, ")"); err != nil {
 		return err
 	}
-	if err := v.validateIndexParameters(); err != nil {
+	if err := v.validateIndexParameters(true); err != nil {
 		return err
 	}
 	return nil
@@ -707,6 +1662,9 @@ func (v *postgresqlValidator) validateTableConstraintUnique() error {
 	if err := v.validateToken(true, "UNIQUE"); err != nil {
 		return err
 	}
+	if err := v.validateNullsDistinct(); err != nil {
+		return err
+	}
 	if err := v.validateToken(true, "("); err != nil {
 		return err
 	}
@@ -716,7 +1674,7 @@ func (v *postgresqlValidator) validateTableConstraintUnique() error {
 	if err := v.validateToken(true, ")"); err != nil {
 		return err
 	}
-	if err := v.validateIndexParameters(); err != nil {
+	if err := v.validateIndexParameters(true); err != nil {
 		return err
 	}
 	return nil
@@ -777,22 +1735,83 @@ func (v *postgresqlValidator) validateTableConstraintForeignKey() error {
 
 
 func (v *postgresqlValidator) validateTableConstraintExclude() error {
-	if err := v.validateToken(false, "EXCLUDE"); err != nil {
+	if err := v.validateToken(true, "EXCLUDE"); err != nil {
 		return err
 	}
-	if v.matchTokenNext(false, "USING") {
-		if err := v.validateName(false); err != nil {
+	if v.matchTokenNext(true, "USING") {
+		if err := v.validateName(true); err != nil {
 			return err
 		}
 	}
-	if err := v.validateBrackets(false); err != nil {
+	if err := v.validateToken(true, "("); err != nil {
+		return err
+	}
+	if err := v.validateExcludeElements(); err != nil {
+		return err
+	}
+	if err := v.validateToken(true, ")"); err != nil {
+		return err
+	}
+	if err := v.validateIndexParameters(true); err != nil {
+		return err
+	}
+	if v.matchTokenNext(true, "WHERE") {
+		if err := v.validateExpr(true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+
+// exclude_element WITH operator [, ... ]
+func (v *postgresqlValidator) validateExcludeElements() error {
+	if err := v.validateExcludeElement(); err != nil {
 		return err
 	}
-	if err := v.validateIndexParameters(); err != nil {
+	if err := v.validateToken(true, "WITH"); err != nil {
 		return err
 	}
-	if v.matchTokenNext(false, "WHERE") {
-		if err := v.validateBrackets(false); err != nil {
+	if v.matchToken(",", ")") {
+		return v.syntaxError()
+	}
+	v.set(v.next())
+	if v.matchTokenNext(true, ",") {
+		return v.validateExcludeElements()
+	}
+	return nil
+}
+
+
+// {column_name | (expression)} [COLLATE collation] [opclass [(...)]] [ASC | DESC] [NULLS {FIRST | LAST}]
+func (v *postgresqlValidator) validateExcludeElement() error {
+	if v.matchToken("(") {
+		if err := v.validateExpr(true); err != nil {
+			return err
+		}
+	} else {
+		if err := v.validateColumnName(true); err != nil {
+			return err
+		}
+	}
+	if v.matchTokenNext(false, "COLLATE") {
+		if err := v.validateTableName(false); err != nil {
+			return err
+		}
+	}
+	if !v.matchToken("WITH", "ASC", "DESC", "NULLS") {
+		if err := v.validateTableName(false); err != nil {
+			return err
+		}
+		if v.matchToken("(") {
+			if err := v.validateBrackets(false); err != nil {
+				return err
+			}
+		}
+	}
+	v.matchTokenNext(false, "ASC", "DESC")
+	if v.matchTokenNext(false, "NULLS") {
+		if err := v.validateToken(false, "FIRST", "LAST"); err != nil {
 			return err
 		}
 	}
@@ -800,7 +1819,7 @@ func (v *postgresqlValidator) validateTableConstraintExclude() error {
 }
 
 
-func (v *postgresqlValidator) validateTableOptions() error {
+func (v *postgresqlValidator) validateTableOptions(temporary bool) error {
 	if (v.isOutOfRange()) {
 		return nil
 	}
@@ -810,14 +1829,15 @@ func (v *postgresqlValidator) validateTableOptions() error {
 	if v.matchToken(",") {
 		v.next()
 	}
-	if err := v.validateTableOption(); err != nil {
+	if err := v.validateTableOption(temporary); err != nil {
 		return err
 	}
-	return v.validateTableOptions()
+	return v.validateTableOptions(temporary)
 }
 
 
-func (v *postgresqlValidator) validateTableOption() error {
+// ON COMMIT is for the temporary tables only.
+func (v *postgresqlValidator) validateTableOption(temporary bool) error {
 	if v.matchToken("WITH") {
 		return v.validateTableOptionWith()
 	}
@@ -836,10 +1856,34 @@ func (v *postgresqlValidator) validateTableOption() error {
 	if v.matchToken("USING") {
 		return v.validateTableOptionUsing()
 	}
+	if temporary && v.matchToken("ON") {
+		return v.validateTableOptionOnCommit()
+	}
 	return v.syntaxError()
 }
 
 
+// ON COMMIT {PRESERVE ROWS | DELETE ROWS | DROP}
+func (v *postgresqlValidator) validateTableOptionOnCommit() error {
+	if err := v.validateToken(true, "ON"); err != nil {
+		return err
+	}
+	if err := v.validateToken(true, "COMMIT"); err != nil {
+		return err
+	}
+	if v.matchTokenNext(true, "DROP") {
+		return nil
+	}
+	if err := v.validateToken(true, "PRESERVE", "DELETE"); err != nil {
+		return err
+	}
+	if err := v.validateToken(true, "ROWS"); err != nil {
+		return err
+	}
+	return nil
+}
+
+
 func (v *postgresqlValidator) validateTableOptionWith() error {
 	if err := v.validateToken(false, "WITH"); err != nil {
 		return err
@@ -962,6 +2006,12 @@ var DataType_PostgreSQL = []string{
 	"TIMETZ",
 	"TIMESTAMP",
 	"TIMESTAMPTZ",
+	"INT4RANGE",
+	"INT8RANGE",
+	"NUMRANGE",
+	"TSRANGE",
+	"TSTZRANGE",
+	"DATERANGE",
 	"TSQUERY",
 	"TSVECTOR",
 	"TXID_SNAPSHOT",
@@ -1233,7 +2283,6 @@ var ReservedWords_PostgreSQL = []string{
 	"PREFIX",
 	"PREORDER",
 	"PRIMARY",
-	"PUBLIC",
 	"RANGE",
 	"RANK",
 	"READS",
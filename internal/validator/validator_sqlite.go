@@ -29,9 +29,11 @@ func (v *sqliteValidator) validate() error {
 	if (v.isOutOfRange()) {
 		return nil
 	}
+	begin := len(v.result)
 	if err := v.validateDdl(); err != nil {
 		return err
 	}
+	v.dropTrivia(begin)
 	return v.validate()
 }
 
@@ -43,7 +45,7 @@ func (v *sqliteValidator) isStringValue(token string) bool {
 
 func (v *sqliteValidator) isIdentifier(token string) bool {
 	tmp := token[0:1]
-	return tmp == "\"" || tmp == "`"
+	return tmp == "\"" || tmp == "`" || tmp == "["
 }
 
 
@@ -106,12 +108,19 @@ func (v *sqliteValidator) validateExpr(set bool) error {
 
 
 func (v *sqliteValidator) validateDdl() error {
+	if v.matchToken("DROP") {
+		return v.validateDrop()
+	}
 	if err := v.validateToken(false, "CREATE"); err != nil {
 		return err
 	}
-	v.matchTokenNext(false, "TEMP", "TEMPORARY")
+	temporary := v.matchTokenNext(false, "TEMP", "TEMPORARY")
 	if v.matchToken("TABLE") {
-		if err := v.validateCreateTable(); err != nil {
+		if err := v.validateCreateTable(temporary); err != nil {
+			return err
+		}
+	} else if v.matchToken("VIRTUAL") && !temporary {
+		if err := v.validateCreateVirtualTable(); err != nil {
 			return err
 		}
 	} else {
@@ -123,8 +132,43 @@ func (v *sqliteValidator) validateDdl() error {
 }
 
 
-func (v *sqliteValidator) validateCreateTable() error {
+func (v *sqliteValidator) validateCreateTable(temporary bool) error {
 	v.set("CREATE")
+	if temporary {
+		v.set("TEMPORARY")
+	}
+	if err := v.validateToken(true, "TABLE"); err != nil {
+		return err
+	}
+	if err := v.validateIfNotExists(); err != nil {
+		return err
+	}
+	if err := v.validateCreateTableName(); err != nil {
+		return err
+	}
+	if v.matchTokenNext(true, "AS") {
+		if err := v.validateQuery(); err != nil {
+			return err
+		}
+	} else if err := v.validateTableDefinition(); err != nil {
+		return err
+	}
+	if v.options.SQLiteSchema && v.isOutOfRange() {
+		return nil
+	}
+	if err := v.validateToken(true, ";"); err != nil {
+		return err
+	}
+	return nil
+}
+
+
+// CREATE VIRTUAL TABLE [IF NOT EXISTS] [schema_name.]table_name USING module_name [(module-argument, ...)];
+func (v *sqliteValidator) validateCreateVirtualTable() error {
+	v.set("CREATE")
+	if err := v.validateToken(true, "VIRTUAL"); err != nil {
+		return err
+	}
 	if err := v.validateToken(true, "TABLE"); err != nil {
 		return err
 	}
@@ -134,9 +178,20 @@ func (v *sqliteValidator) validateCreateTable() error {
 	if err := v.validateTableName(true); err != nil {
 		return err
 	}
-	if err := v.validateTableDefinition(); err != nil {
+	if err := v.validateToken(true, "USING"); err != nil {
+		return err
+	}
+	if err := v.validateName(true); err != nil {
 		return err
 	}
+	if v.matchToken("(") {
+		if err := v.validateModuleArguments(); err != nil {
+			return err
+		}
+	}
+	if v.options.SQLiteSchema && v.isOutOfRange() {
+		return nil
+	}
 	if err := v.validateToken(true, ";"); err != nil {
 		return err
 	}
@@ -144,6 +199,32 @@ func (v *sqliteValidator) validateCreateTable() error {
 }
 
 
+/*
+  (module-argument, ...)
+  The arguments are read by the module (fts5: column names and options such as
+  tokenize = 'porter'). Any tokens are accepted up to "," or ")" outside brackets.
+*/
+func (v *sqliteValidator) validateModuleArguments() error {
+	v.set(v.next()) // "("
+	depth := 0
+	for !v.isOutOfRange() {
+		if depth == 0 && v.matchToken(")") {
+			v.set(v.next())
+			return nil
+		}
+		if v.matchToken("(") {
+			depth += 1
+		} else if v.matchToken(")") {
+			depth -= 1
+		} else if v.matchToken(";") {
+			break
+		}
+		v.set(v.next())
+	}
+	return v.syntaxError()
+}
+
+
 func (v *sqliteValidator) validateCreateOther() error {
 	if err := v.validateToken(false, "VIRTUAL", "VIEW", "TRIGGER", "INDEX", "UNIQUE"); err != nil {
 		return err
@@ -151,6 +232,9 @@ func (v *sqliteValidator) validateCreateOther() error {
 	begin := false
 	for true {
 		if v.isOutOfRange() {
+			if v.options.SQLiteSchema && !begin {
+				return nil
+			}
 			return v.syntaxError()
 		}
 		if v.matchToken("BEGIN") {
@@ -174,6 +258,57 @@ func (v *sqliteValidator) validateCreateOther() error {
 }
 
 
+/*
+  The shadow tables of virtual tables are created as CREATE TABLE 'docs_data'(...)
+  and SQLite reads the string as a name. It is set as "docs_data".
+*/
+func (v *sqliteValidator) validateCreateTableName() error {
+	if v.options.SQLiteSchema && v.isStringValue(v.token()) {
+		name := v.next()
+		name = strings.ReplaceAll(name[1:len(name) - 1], "''", "'")
+		v.set("\"" + strings.ReplaceAll(name, "\"", "\"\"") + "\"")
+		return nil
+	}
+	return v.validateTableName(true)
+}
+
+
+// DROP TABLE [IF EXISTS] [schema_name.]table_name;
+// DROP {INDEX | VIEW | TRIGGER} [IF EXISTS] [schema_name.]name;
+func (v *sqliteValidator) validateDrop() error {
+	if err := v.validateToken(false, "DROP"); err != nil {
+		return err
+	}
+	isTable := v.matchToken("TABLE")
+	if isTable {
+		v.set("DROP")
+	}
+	if err := v.validateToken(isTable, "TABLE", "INDEX", "VIEW", "TRIGGER"); err != nil {
+		return err
+	}
+	if err := v.validateIfExists(); err != nil {
+		return err
+	}
+	if err := v.validateTableName(isTable); err != nil {
+		return err
+	}
+	if err := v.validateToken(isTable, ";"); err != nil {
+		return err
+	}
+	return nil
+}
+
+
+func (v *sqliteValidator) validateIfExists() error {
+	if v.matchTokenNext(false, "IF") {
+		if err := v.validateToken(false, "EXISTS"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+
 func (v *sqliteValidator) validateIfNotExists() error {
 	if v.matchTokenNext(true, "IF") {
 		if err := v.validateToken(true, "NOT"); err != nil {
@@ -222,8 +357,10 @@ func (v *sqliteValidator) validateColumnDefinition() error {
 	if err := v.validateColumnName(true); err != nil {
 		return err
 	}
-	if err := v.validateColumnType(); err != nil {
-		return err
+	if !v.isTypeOmitted() {
+		if err := v.validateColumnType(); err != nil {
+			return err
+		}
 	}
 	if err := v.validateColumnConstraints(); err != nil {
 		return err
@@ -232,24 +369,96 @@ func (v *sqliteValidator) validateColumnDefinition() error {
 }
 
 
+// The type may be omitted (CREATE TABLE sqlite_sequence(name,seq)).
+func (v *sqliteValidator) isTypeOmitted() bool {
+	return v.matchToken(",", ")", "CONSTRAINT") || v.isColumnConstraint(v.token())
+}
+
+
 func (v *sqliteValidator) validateColumnType() error {
-	return v.validateToken(true,
-		"TEXT", "NUMERIC", "INTEGER", "REAL", "NONE",
-	)
+	if v.options.SQLiteSchema {
+		return v.validateTypeName()
+	}
+	if v.matchTokenNext(true, "DOUBLE") {
+		v.matchTokenNext(false, "PRECISION")
+		return v.validateTypeDigit()
+	}
+	if err := v.validateToken(true, DataType_SQLite...); err != nil {
+		return err
+	}
+	return v.validateTypeDigit()
+}
+
+
+/*
+  name [name ...] [(number [, number])]
+  SQLite takes any sequence of names as the type (TIMESTAMP, UNSIGNED BIG INT)
+  and derives the affinity from it. Accepted for a schema read from a database.
+*/
+func (v *sqliteValidator) validateTypeName() error {
+	if err := v.validateName(true); err != nil {
+		return err
+	}
+	for !v.isOutOfRange() && !v.matchToken("(") && !v.isTypeOmitted() {
+		if err := v.validateName(true); err != nil {
+			return err
+		}
+	}
+	return v.validateTypeDigit()
+}
+
+
+// (number [, number])
+func (v *sqliteValidator) validateTypeDigit() error {
+	if v.matchTokenNext(true, "(") {
+		if err := v.validatePositiveInteger(); err != nil {
+			return err
+		}
+		if v.matchTokenNext(true, ",") {
+			if err := v.validatePositiveInteger(); err != nil {
+				return err
+			}
+		}
+		if err := v.validateToken(true, ")"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+
+func (v *sqliteValidator) validatePositiveInteger() error {
+	if !common.IsPositiveIntegerToken(v.token()) {
+		return v.syntaxError()
+	}
+	v.set(v.next())
+	return nil
 }
 
 
 func (v *sqliteValidator) validateColumnConstraints() error {
+	return v.validateColumnConstraintsAux([]string{})
+}
+
+
+// [CONSTRAINT name] column-constraint
+func (v *sqliteValidator) validateConstraintName() error {
 	if v.matchTokenNext(true, "CONSTRAINT") {
 		if err := v.validateName(true); err != nil {
 			return err
 		}
+		if !v.isColumnConstraint(v.token()) {
+			return v.syntaxError()
+		}
 	}
-	return v.validateColumnConstraintsAux([]string{})
+	return nil
 }
 
 
 func (v *sqliteValidator) validateColumnConstraintsAux(ls []string) error {
+	if err := v.validateConstraintName(); err != nil {
+		return err
+	}
 	if !v.isColumnConstraint(v.token()) {
 		return nil
 	} 
@@ -308,7 +517,7 @@ func (v *sqliteValidator) validateConstraintPrimaryKey() error {
 	if err := v.validateToken(true, "KEY"); err != nil {
 		return err
 	}
-	v.matchTokenNext(false, "ASC", "DESC")
+	v.matchTokenNext(true, "ASC", "DESC")
 	if err := v.validateConflictClause(); err != nil {
 		return err
 	}
@@ -474,20 +683,23 @@ func (v *sqliteValidator) validateConstraintGenerated() error {
 	if err := v.validateToken(false, "AS"); err != nil {
 		return err
 	}
-	if err := v.validateExpr(false); err != nil {
+	v.set("GENERATED")
+	v.set("ALWAYS")
+	v.set("AS")
+	if err := v.validateExpr(true); err != nil {
 		return err
 	}
-	v.matchTokenNext(false, "STORED", "VIRTUAL")
+	v.matchTokenNext(true, "STORED", "VIRTUAL")
 	return nil
 }
 
 
 func (v *sqliteValidator) validateConflictClause() error {
-	if v.matchTokenNext(false, "ON") {
-		if err := v.validateToken(false, "CONFLICT"); err != nil {
+	if v.matchTokenNext(true, "ON") {
+		if err := v.validateToken(true, "CONFLICT"); err != nil {
 			return err
 		}
-		if err := v.validateToken(false, "ROLLBACK", "ABORT", "FAIL", "IGNORE","REPLACE"); err != nil {
+		if err := v.validateToken(true, "ROLLBACK", "ABORT", "FAIL", "IGNORE","REPLACE"); err != nil {
 			return err
 		}
 	}
@@ -528,7 +740,7 @@ func (v *sqliteValidator) validateTableConstraintPrimaryKey() error {
 	if err := v.validateToken(true, "("); err != nil {
 		return err
 	}
-	if err := v.validateCommaSeparatedColumnNames(true); err != nil {
+	if err := v.validateIndexedColumnNames(true); err != nil {
 		return v.syntaxError()
 	}
 	if err := v.validateToken(true, ")"); err != nil {
@@ -541,6 +753,20 @@ func (v *sqliteValidator) validateTableConstraintPrimaryKey() error {
 }
 
 
+// column_name [ASC | DESC], ...
+// ASC and DESC are set for PRIMARY KEY only.
+func (v *sqliteValidator) validateIndexedColumnNames(setOrder bool) error {
+	if err := v.validateColumnName(true); err != nil {
+		return err
+	}
+	v.matchTokenNext(setOrder, "ASC", "DESC")
+	if v.matchTokenNext(true, ",") {
+		return v.validateIndexedColumnNames(setOrder)
+	}
+	return nil
+}
+
+
 func (v *sqliteValidator) validateTableConstraintUnique() error {
 	if err := v.validateToken(true, "UNIQUE"); err != nil {
 		return err
@@ -548,7 +774,7 @@ func (v *sqliteValidator) validateTableConstraintUnique() error {
 	if err := v.validateToken(true, "("); err != nil {
 		return err
 	}
-	if err := v.validateCommaSeparatedColumnNames(true, ); err != nil {
+	if err := v.validateIndexedColumnNames(false); err != nil {
 		return err
 	}
 	if err := v.validateToken(true, ")"); err != nil {
@@ -613,8 +839,8 @@ func (v *sqliteValidator) validateTableOptions() error {
 	if (v.isOutOfRange()) {
 		return nil
 	}
-	if v.matchTokenNext(false, "WITHOUT") {
-		if err := v.validateToken(false, "ROWID"); err != nil {
+	if v.matchTokenNext(true, "WITHOUT") {
+		if err := v.validateToken(true, "ROWID"); err != nil {
 			return err
 		}
 		if v.matchTokenNext(false, ",") {
@@ -624,10 +850,10 @@ func (v *sqliteValidator) validateTableOptions() error {
 		}
 	} else if v.matchTokenNext(false, "STRICT") {
 		if v.matchTokenNext(false, ",") {
-			if err := v.validateToken(false, "WITHOUT"); err != nil {
+			if err := v.validateToken(true, "WITHOUT"); err != nil {
 				return err
 			}
-			if err := v.validateToken(false, "ROWID"); err != nil {
+			if err := v.validateToken(true, "ROWID"); err != nil {
 				return err
 			}
 		}
@@ -773,4 +999,37 @@ var ReservedWords_SQLite = []string{
 	"WINDOW",
 	"WITH",
 	"WITHOUT",
+}
+
+
+/*
+  The five affinities plus the type names listed in
+  https://www.sqlite.org/datatype3.html (affinity name examples)
+  and ANY of STRICT tables.
+*/
+var DataType_SQLite = []string{
+	"TEXT",
+	"NUMERIC",
+	"INTEGER",
+	"REAL",
+	"NONE",
+	"BLOB",
+	"INT",
+	"TINYINT",
+	"SMALLINT",
+	"MEDIUMINT",
+	"BIGINT",
+	"INT2",
+	"INT8",
+	"CHARACTER",
+	"VARCHAR",
+	"NCHAR",
+	"NVARCHAR",
+	"CLOB",
+	"FLOAT",
+	"DECIMAL",
+	"BOOLEAN",
+	"DATE",
+	"DATETIME",
+	"ANY",
 }
\ No newline at end of file
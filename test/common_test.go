@@ -20,11 +20,27 @@ type (
 	DataType = types.DataType
 	Constraint = types.Constraint
 	Reference = types.Reference
+	DefaultValue = types.DefaultValue
+	Generated = types.Generated
+	Expression = types.Expression
+	Expr = types.Expr
+	CaseWhen = types.CaseWhen
+	Identity = types.Identity
+	SequenceOptions = types.SequenceOptions
+	Sequence = types.Sequence
+	SequenceOwner = types.SequenceOwner
+	Result = types.Result
+	Schema = types.Schema
 	TableConstraint = types.TableConstraint
 	PrimaryKey = types.PrimaryKey
 	Unique = types.Unique
+	IndexParameters = types.IndexParameters
 	Check = types.Check
 	ForeignKey = types.ForeignKey
+	Exclude = types.Exclude
+	ExcludeElement = types.ExcludeElement
+	SQLiteMasterRow = types.SQLiteMasterRow
+	PgDumpEntry = types.PgDumpEntry
 )
 
 type (
@@ -45,7 +61,8 @@ func lex (ddl string, rdbms Rdbms) ([]string, error) {
 }
 
 func validate (ddl string, rdbms Rdbms) ([]string, error) {
-	tokens, err := lex(ddl, rdbms)
+	l := lexer.NewLexerWithOptions(rdbms, common.Options{})
+	tokens, err := l.Lex(ddl)
 	if err != nil {
 		return []string{}, err
 	}
@@ -61,7 +78,7 @@ func convert (ddl string, rdbms Rdbms) ([]Table, error) {
 	}
 
 	c := converter.NewConverter(rdbms)
-	return c.Convert(tokens), nil
+	return c.Convert(tokens).Tables, nil
 }
 
 
@@ -99,6 +99,7 @@ func TestConvert_MySQL(t *testing.T) {
 		  "schema": "",
 		  "name": "test_table",
 		  "if_not_exists": false,
+		  "comment": "string",
 		  "columns": [
 			{
 			  "name": "aaa1",
@@ -158,6 +159,7 @@ func TestConvert_MySQL(t *testing.T) {
 				"is_not_null": true,
 				"is_autoincrement": false,
 				"default": -1,
+				"default_value": {"kind": "number", "value": "-1"},
 				"check": "",
 				"collate": "",
 				"references": {
@@ -180,6 +182,7 @@ func TestConvert_MySQL(t *testing.T) {
 				"is_not_null": false,
 				"is_autoincrement": false,
 				"default": null,
+				"default_value": {"kind": "null"},
 				"check": "",
 				"collate": "",
 				"references": {
@@ -202,6 +205,7 @@ func TestConvert_MySQL(t *testing.T) {
 				"is_not_null": false,
 				"is_autoincrement": false,
 				"default": "a",
+				"default_value": {"kind": "string", "value": "a"},
 				"check": "",
 				"collate": "",
 				"references": {
@@ -224,6 +228,7 @@ func TestConvert_MySQL(t *testing.T) {
 				"is_not_null": false,
 				"is_autoincrement": false,
 				"default": "a",
+				"default_value": {"kind": "string", "value": "a"},
 				"check": "",
 				"collate": "",
 				"references": {
@@ -246,6 +251,7 @@ func TestConvert_MySQL(t *testing.T) {
 				"is_not_null": false,
 				"is_autoincrement": false,
 				"default": true,
+				"default_value": {"kind": "boolean", "value": "true"},
 				"check": "",
 				"collate": "",
 				"references": {
@@ -268,6 +274,23 @@ func TestConvert_MySQL(t *testing.T) {
 				"is_not_null": false,
 				"is_autoincrement": false,
 				"default": "(expr(aaa))",
+				"default_value": {
+				  "kind": "expression",
+				  "value": "(expr(aaa))",
+				  "expression": {
+					"source": "expr(aaa)",
+					"tree": {
+					  "kind": "function",
+					  "name": "expr",
+					  "args": [
+						{
+						  "kind": "column",
+						  "name": "aaa"
+						}
+					  ]
+					}
+				  }
+				},
 				"check": "",
 				"collate": "",
 				"references": {
@@ -296,7 +319,8 @@ func TestConvert_MySQL(t *testing.T) {
 				  "table_name": "",
 				  "column_names": null
 				}
-			  }
+			  },
+			  "comment": "string"
 			},
 			{
 			  "name": "aa10",
@@ -493,6 +517,9 @@ func TestConvert_MySQL(t *testing.T) {
 				"is_autoincrement": false,
 				"default": null,
 				"check": "(aaa()'bbb'(aaa))",
+				"check_expression": {
+				  "source": "aaa()'bbb'(aaa)"
+				},
 				"collate": "",
 				"references": {
 				  "table_name": "",
@@ -515,6 +542,13 @@ func TestConvert_MySQL(t *testing.T) {
 				"is_autoincrement": false,
 				"default": null,
 				"check": "(aaa)",
+				"check_expression": {
+				  "source": "aaa",
+				  "tree": {
+					"kind": "column",
+					"name": "aaa"
+				  }
+				},
 				"collate": "",
 				"references": {
 				  "table_name": "",
@@ -536,6 +570,14 @@ func TestConvert_MySQL(t *testing.T) {
 				"is_not_null": false,
 				"is_autoincrement": false,
 				"default": null,
+				"generated": {
+				  "source": "generation_expr",
+				  "tree": {
+					"kind": "column",
+					"name": "generation_expr"
+				  },
+				  "stored": false
+				},
 				"check": "",
 				"collate": "",
 				"references": {
@@ -558,6 +600,14 @@ func TestConvert_MySQL(t *testing.T) {
 				"is_not_null": false,
 				"is_autoincrement": false,
 				"default": null,
+				"generated": {
+				  "source": "generation_expr",
+				  "tree": {
+					"kind": "column",
+					"name": "generation_expr"
+				  },
+				  "stored": false
+				},
 				"check": "",
 				"collate": "",
 				"references": {
@@ -602,6 +652,7 @@ func TestConvert_MySQL(t *testing.T) {
 				"is_not_null": true,
 				"is_autoincrement": false,
 				"default": -1,
+				"default_value": {"kind": "number", "value": "-1"},
 				"check": "",
 				"collate": "",
 				"references": {
@@ -734,6 +785,8 @@ func TestConvert_MySQL(t *testing.T) {
 				"is_not_null": false,
 				"is_autoincrement": false,
 				"default": "current_timestamp",
+				"default_value": {"kind": "keyword", "value": "CURRENT_TIMESTAMP"},
+				"on_update": "current_timestamp",
 				"check": "",
 				"collate": "",
 				"references": {
@@ -757,7 +810,7 @@ func TestConvert_MySQL(t *testing.T) {
 			],
 			"unique": [
 			  {
-				"name": "",
+				"name": "index_zzzz",
 				"column_names": [
 				  "aa25",
 				  "aa26",
@@ -769,7 +822,14 @@ func TestConvert_MySQL(t *testing.T) {
 			"check": [
 			  {
 				"name": "constraint_zzzz",
-				"expr": "(aaa)"
+				"expr": "(aaa)",
+				"expression": {
+				  "source": "aaa",
+				  "tree": {
+					"kind": "column",
+					"name": "aaa"
+				  }
+				}
 			  }
 			],
 			"foreign_key": [
@@ -795,10 +855,12 @@ func TestConvert_MySQL(t *testing.T) {
 		{
 		  "schema": "scm",
 		  "name": "test_table2",
+		  "name_quoted": true,
 		  "if_not_exists": true,
 		  "columns": [
 			{
 			  "name": "aaa1",
+			  "name_quoted": true,
 			  "data_type": {
 				"name": "INTEGER",
 				"digit_n": 0,
@@ -830,4 +892,675 @@ func TestConvert_MySQL(t *testing.T) {
 	  ]`
 
 	tr.ConvertOK(ddl, EXPECT_JSON)
+
+	ddl = `CREATE TEMPORARY TABLE scratch (id int) ENGINE = InnoDB;`
+
+	EXPECT_JSON = `[
+		{
+		  "schema": "",
+		  "name": "scratch",
+		  "if_not_exists": false,
+		  "temporary": true,
+		  "columns": [
+			{
+			  "name": "id",
+			  "data_type": {
+				"name": "INT",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			}
+		  ],
+		  "constraints": {
+			"primary_key": null,
+			"unique": null,
+			"check": null,
+			"foreign_key": null
+		  }
+		}
+	  ]`
+
+	tr.ConvertOK(ddl, EXPECT_JSON)
+
+	ddl = `create table users (
+		id int auto_increment primary key,
+		name varchar(50) not null default 'x',
+		org_id int,
+		foreign key (org_id) references orgs (id)
+	);
+	create table users_copy like users;
+	create table users_copy2 (like users);
+	create table users_names select id, name from users;`
+
+	EXPECT_JSON = `[
+		{
+		  "schema": "",
+		  "name": "users",
+		  "if_not_exists": false,
+		  "columns": [
+			{
+			  "name": "id",
+			  "data_type": {
+				"name": "INT",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": true,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": true,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			},
+			{
+			  "name": "name",
+			  "data_type": {
+				"name": "VARCHAR",
+				"digit_n": 50,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": true,
+				"is_autoincrement": false,
+				"default": "x",
+				"default_value": {"kind": "string", "value": "x"},
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			},
+			{
+			  "name": "org_id",
+			  "data_type": {
+				"name": "INT",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			}
+		  ],
+		  "constraints": {
+			"primary_key": null,
+			"unique": null,
+			"check": null,
+			"foreign_key": [
+			  {
+				"name": "",
+				"column_names": [
+				  "org_id"
+				],
+				"references": {
+				  "table_name": "orgs",
+				  "column_names": [
+					"id"
+				  ]
+				}
+			  }
+			]
+		  }
+		},
+		{
+		  "schema": "",
+		  "name": "users_copy",
+		  "if_not_exists": false,
+		  "columns": [
+			{
+			  "name": "id",
+			  "data_type": {
+				"name": "INT",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": true,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": true,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			},
+			{
+			  "name": "name",
+			  "data_type": {
+				"name": "VARCHAR",
+				"digit_n": 50,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": true,
+				"is_autoincrement": false,
+				"default": "x",
+				"default_value": {"kind": "string", "value": "x"},
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			},
+			{
+			  "name": "org_id",
+			  "data_type": {
+				"name": "INT",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			}
+		  ],
+		  "constraints": {
+			"primary_key": null,
+			"unique": null,
+			"check": null,
+			"foreign_key": null
+		  }
+		},
+		{
+		  "schema": "",
+		  "name": "users_copy2",
+		  "if_not_exists": false,
+		  "columns": [
+			{
+			  "name": "id",
+			  "data_type": {
+				"name": "INT",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": true,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": true,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			},
+			{
+			  "name": "name",
+			  "data_type": {
+				"name": "VARCHAR",
+				"digit_n": 50,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": true,
+				"is_autoincrement": false,
+				"default": "x",
+				"default_value": {"kind": "string", "value": "x"},
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			},
+			{
+			  "name": "org_id",
+			  "data_type": {
+				"name": "INT",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			}
+		  ],
+		  "constraints": {
+			"primary_key": null,
+			"unique": null,
+			"check": null,
+			"foreign_key": null
+		  }
+		},
+		{
+		  "schema": "",
+		  "name": "users_names",
+		  "if_not_exists": false,
+		  "columns": [],
+		  "constraints": {
+			"primary_key": null,
+			"unique": null,
+			"check": null,
+			"foreign_key": null
+		  },
+		  "query": "select id, name from users"
+		}
+	  ]`
+
+	tr.ConvertOK(ddl, EXPECT_JSON)
+
+
+	ddl = `CREATE TABLE accounts (
+		id bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+		code int(6) unsigned zerofill,
+		balance decimal(12,2) NOT NULL DEFAULT '0.00',
+		name varchar(100) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL,
+		note longtext CHARSET latin1,
+		PRIMARY KEY (id)
+	);`
+
+	EXPECT_JSON = `[
+		{
+		  "schema": "",
+		  "name": "accounts",
+		  "if_not_exists": false,
+		  "columns": [
+			{
+			  "name": "id",
+			  "data_type": {
+				"name": "BIGINT",
+				"digit_n": 20,
+				"digit_m": 0,
+				"unsigned": true
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": true,
+				"is_autoincrement": true,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			},
+			{
+			  "name": "code",
+			  "data_type": {
+				"name": "INT",
+				"digit_n": 6,
+				"digit_m": 0,
+				"unsigned": true,
+				"zerofill": true
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			},
+			{
+			  "name": "balance",
+			  "data_type": {
+				"name": "DECIMAL",
+				"digit_n": 12,
+				"digit_m": 2
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": true,
+				"is_autoincrement": false,
+				"default": "0.00",
+				"default_value": {"kind": "string", "value": "0.00"},
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			},
+			{
+			  "name": "name",
+			  "data_type": {
+				"name": "VARCHAR",
+				"digit_n": 100,
+				"digit_m": 0,
+				"charset": "utf8mb4"
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": true,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "",
+				"collate": "utf8mb4_bin",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			},
+			{
+			  "name": "note",
+			  "data_type": {
+				"name": "LONGTEXT",
+				"digit_n": 0,
+				"digit_m": 0,
+				"charset": "latin1"
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			}
+		  ],
+		  "constraints": {
+			"primary_key": [
+			  {
+				"name": "",
+				"column_names": [
+				  "id"
+				]
+			  }
+			],
+			"unique": null,
+			"check": null,
+			"foreign_key": null
+		  }
+		}
+	  ]`
+
+	tr.ConvertOK(ddl, EXPECT_JSON)
+
+	ddl = `create table events (
+		created_at timestamp(6) not null default current_timestamp(6),
+		updated_at timestamp(6) not null default current_timestamp(6) on update current_timestamp(6),
+		touched_at datetime null on update now()
+	);`
+
+	EXPECT_JSON = `[
+		{
+		  "schema": "",
+		  "name": "events",
+		  "if_not_exists": false,
+		  "columns": [
+			{
+			  "name": "created_at",
+			  "data_type": {
+				"name": "TIMESTAMP",
+				"digit_n": 6,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": true,
+				"is_autoincrement": false,
+				"default": "current_timestamp(6)",
+				"default_value": {
+				  "kind": "function",
+				  "value": "current_timestamp(6)",
+				  "expression": {
+					"source": "current_timestamp(6)",
+					"tree": {
+					  "kind": "function",
+					  "name": "current_timestamp",
+					  "args": [
+						{
+						  "kind": "number",
+						  "value": "6"
+						}
+					  ]
+					}
+				  }
+				},
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			},
+			{
+			  "name": "updated_at",
+			  "data_type": {
+				"name": "TIMESTAMP",
+				"digit_n": 6,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": true,
+				"is_autoincrement": false,
+				"default": "current_timestamp(6)",
+				"default_value": {
+				  "kind": "function",
+				  "value": "current_timestamp(6)",
+				  "expression": {
+					"source": "current_timestamp(6)",
+					"tree": {
+					  "kind": "function",
+					  "name": "current_timestamp",
+					  "args": [
+						{
+						  "kind": "number",
+						  "value": "6"
+						}
+					  ]
+					}
+				  }
+				},
+				"on_update": "current_timestamp(6)",
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			},
+			{
+			  "name": "touched_at",
+			  "data_type": {
+				"name": "DATETIME",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": false,
+				"default": null,
+				"on_update": "now()",
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			}
+		  ],
+		  "constraints": {
+			"primary_key": null,
+			"unique": null,
+			"check": null,
+			"foreign_key": null
+		  }
+		}
+	  ]`
+
+	tr.ConvertOK(ddl, EXPECT_JSON)
+
+	ddl = `create table users (
+		id int comment 'it''s id',
+		name varchar(10) comment "name"
+	) comment = 'users' engine = InnoDB;`
+
+	EXPECT_JSON = `[
+		{
+		  "schema": "",
+		  "name": "users",
+		  "if_not_exists": false,
+		  "comment": "users",
+		  "columns": [
+			{
+			  "name": "id",
+			  "data_type": {
+				"name": "INT",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  },
+			  "comment": "it's id"
+			},
+			{
+			  "name": "name",
+			  "data_type": {
+				"name": "VARCHAR",
+				"digit_n": 10,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  },
+			  "comment": "name"
+			}
+		  ],
+		  "constraints": {
+			"primary_key": null,
+			"unique": null,
+			"check": null,
+			"foreign_key": null
+		  }
+		}
+	  ]`
+
+	tr.ConvertOK(ddl, EXPECT_JSON)
 }
\ No newline at end of file
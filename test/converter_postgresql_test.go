@@ -50,7 +50,9 @@ func TestConvert_PostgreSQL(t *testing.T) {
 	EXPECT_JSON := `[
 		{
 		  "schema": "scm",
+		  "schema_quoted": true,
 		  "name": "test_table",
+		  "name_quoted": true,
 		  "if_not_exists": false,
 		  "columns": [
 			{
@@ -265,12 +267,14 @@ func TestConvert_PostgreSQL(t *testing.T) {
 				"is_not_null": true,
 				"is_autoincrement": false,
 				"default": 1,
+				"default_value": {"kind": "number", "value": "1"},
 				"check": "",
 				"collate": "",
 				"references": {
 				  "table_name": "",
 				  "column_names": null
-				}
+				},
+				"not_null_name": "constraint_zzzz"
 			  }
 			},
 			{
@@ -287,6 +291,7 @@ func TestConvert_PostgreSQL(t *testing.T) {
 				"is_not_null": false,
 				"is_autoincrement": false,
 				"default": "aaa",
+				"default_value": {"kind": "string", "value": "aaa"},
 				"check": "",
 				"collate": "",
 				"references": {
@@ -309,6 +314,7 @@ func TestConvert_PostgreSQL(t *testing.T) {
 				"is_not_null": false,
 				"is_autoincrement": false,
 				"default": null,
+				"default_value": {"kind": "null"},
 				"check": "",
 				"collate": "",
 				"references": {
@@ -331,6 +337,7 @@ func TestConvert_PostgreSQL(t *testing.T) {
 				"is_not_null": false,
 				"is_autoincrement": false,
 				"default": "current_timestamp",
+				"default_value": {"kind": "keyword", "value": "CURRENT_TIMESTAMP"},
 				"check": "",
 				"collate": "",
 				"references": {
@@ -353,6 +360,7 @@ func TestConvert_PostgreSQL(t *testing.T) {
 				"is_not_null": false,
 				"is_autoincrement": false,
 				"default": true,
+				"default_value": {"kind": "boolean", "value": "true"},
 				"check": "",
 				"collate": "",
 				"references": {
@@ -510,6 +518,9 @@ func TestConvert_PostgreSQL(t *testing.T) {
 				"is_autoincrement": false,
 				"default": null,
 				"check": "(aaa()'bbb'(aaa))",
+				"check_expression": {
+				  "source": "aaa()'bbb'(aaa)"
+				},
 				"collate": "",
 				"references": {
 				  "table_name": "",
@@ -531,6 +542,14 @@ func TestConvert_PostgreSQL(t *testing.T) {
 				"is_not_null": false,
 				"is_autoincrement": false,
 				"default": null,
+				"generated": {
+				  "source": "generation_expr",
+				  "tree": {
+					"kind": "column",
+					"name": "generation_expr"
+				  },
+				  "stored": true
+				},
 				"check": "",
 				"collate": "",
 				"references": {
@@ -541,6 +560,7 @@ func TestConvert_PostgreSQL(t *testing.T) {
 			},
 			{
 			  "name": "aa23",
+			  "name_quoted": true,
 			  "data_type": {
 				"name": "TIME",
 				"digit_n": 10,
@@ -592,7 +612,8 @@ func TestConvert_PostgreSQL(t *testing.T) {
 				  "aaa1",
 				  "aaa2",
 				  "aaa3"
-				]
+				],
+				"tablespace": "tsn"
 			  },
 			  {
 				"name": "aaaaa",
@@ -600,7 +621,8 @@ func TestConvert_PostgreSQL(t *testing.T) {
 				  "aaa1",
 				  "aaa2",
 				  "aaa3"
-				]
+				],
+				"tablespace": "tsn"
 			  }
 			],
 			"unique": [
@@ -610,6 +632,10 @@ func TestConvert_PostgreSQL(t *testing.T) {
 				  "aaa4",
 				  "aaa5",
 				  "aaa6"
+				],
+				"include": [
+				  "bbbb",
+				  "cccc"
 				]
 			  },
 			  {
@@ -618,11 +644,32 @@ func TestConvert_PostgreSQL(t *testing.T) {
 				  "aaa4",
 				  "aaa5",
 				  "aaa6"
+				],
+				"include": [
+				  "bbbb",
+				  "cccc"
 				]
 			  }
 			],
 			"check": null,
-			"foreign_key": null
+			"foreign_key": null,
+			"exclude": [
+			  {
+				"name": "constraint_zzzz",
+				"index_method": "",
+				"elements": [
+				  {
+					"element": "exclude_element",
+					"operator": "operator"
+				  },
+				  {
+					"element": "exclude_element",
+					"operator": "operator"
+				  }
+				],
+				"where": ""
+			  }
+			]
 		  }
 		},
 		{
@@ -663,4 +710,1073 @@ func TestConvert_PostgreSQL(t *testing.T) {
 	  ]`
 
 	tr.ConvertOK(ddl, EXPECT_JSON)
-}
\ No newline at end of file
+
+	ddl = `create table identity_table (
+		aaa1 integer generated always as identity (start with 100 increment by 10 minvalue 1 maxvalue 1000 cache 5 cycle),
+		aaa2 bigint generated by default as identity
+	);`
+
+	EXPECT_JSON = `[
+		{
+		  "schema": "",
+		  "name": "identity_table",
+		  "if_not_exists": false,
+		  "columns": [
+			{
+			  "name": "aaa1",
+			  "data_type": {
+				"name": "INTEGER",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": true,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				},
+				"identity": {
+				  "always": true,
+				  "start": 100,
+				  "increment": 10,
+				  "min_value": 1,
+				  "max_value": 1000,
+				  "cache": 5,
+				  "cycle": true
+				}
+			  }
+			},
+			{
+			  "name": "aaa2",
+			  "data_type": {
+				"name": "BIGINT",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": true,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				},
+				"identity": {
+				  "always": false,
+				  "cycle": false
+				}
+			  }
+			}
+		  ],
+		  "constraints": {
+			"primary_key": null,
+			"unique": null,
+			"check": null,
+			"foreign_key": null
+		  }
+		}
+	  ]`
+
+	tr.ConvertOK(ddl, EXPECT_JSON)
+
+	ddl = `create table booking (
+		room integer,
+		during tsrange,
+		constraint no_double_booking exclude using gist (room WITH =, during WITH &&) include (room) where (room > 0),
+		exclude ((lower(during)) WITH =)
+	);`
+
+	EXPECT_JSON = `[
+		{
+		  "schema": "",
+		  "name": "booking",
+		  "if_not_exists": false,
+		  "columns": [
+			{
+			  "name": "room",
+			  "data_type": {
+				"name": "INTEGER",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			},
+			{
+			  "name": "during",
+			  "data_type": {
+				"name": "TSRANGE",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			}
+		  ],
+		  "constraints": {
+			"primary_key": null,
+			"unique": null,
+			"check": null,
+			"foreign_key": null,
+			"exclude": [
+			  {
+				"name": "no_double_booking",
+				"index_method": "gist",
+				"elements": [
+				  {
+					"element": "room",
+					"operator": "="
+				  },
+				  {
+					"element": "during",
+					"operator": "&&"
+				  }
+				],
+				"include": [
+				  "room"
+				],
+				"where": "(room > 0)"
+			  },
+			  {
+				"name": "",
+				"index_method": "",
+				"elements": [
+				  {
+					"element": "(lower(during))",
+					"operator": "="
+				  }
+				],
+				"where": ""
+			  }
+			]
+		  }
+		}
+	  ]`
+
+	tr.ConvertOK(ddl, EXPECT_JSON)
+
+	ddl = `create table members (
+		id integer primary key using index tablespace tsn,
+		email text unique nulls not distinct with (fillfactor=70),
+		name text,
+		primary key (id) include (name) with (fillfactor = 70, deduplicate_items=off),
+		constraint uq_name unique nulls not distinct (name, email) using index tablespace tsn
+	);`
+
+	EXPECT_JSON = `[
+		{
+		  "schema": "",
+		  "name": "members",
+		  "if_not_exists": false,
+		  "columns": [
+			{
+			  "name": "id",
+			  "data_type": {
+				"name": "INTEGER",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": true,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				},
+				"index_parameters": {
+				  "tablespace": "tsn"
+				}
+			  }
+			},
+			{
+			  "name": "email",
+			  "data_type": {
+				"name": "TEXT",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": true,
+				"is_not_null": false,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				},
+				"nulls_not_distinct": true,
+				"index_parameters": {
+				  "storage_parameters": {
+					"fillfactor": "70"
+				  }
+				}
+			  }
+			},
+			{
+			  "name": "name",
+			  "data_type": {
+				"name": "TEXT",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			}
+		  ],
+		  "constraints": {
+			"primary_key": [
+			  {
+				"name": "",
+				"column_names": [
+				  "id"
+				],
+				"include": [
+				  "name"
+				],
+				"storage_parameters": {
+				  "fillfactor": "70",
+				  "deduplicate_items": "off"
+				}
+			  }
+			],
+			"unique": [
+			  {
+				"name": "uq_name",
+				"column_names": [
+				  "name",
+				  "email"
+				],
+				"nulls_not_distinct": true,
+				"tablespace": "tsn"
+			  }
+			],
+			"check": null,
+			"foreign_key": null
+		  }
+		}
+	  ]`
+
+	tr.ConvertOK(ddl, EXPECT_JSON)
+
+	ddl = `create temp table scratch1 (id integer) on commit drop;
+	create global temporary table scratch2 (id integer) with (fillfactor=70) on commit delete rows;
+	create local temp table scratch3 (id integer) on commit preserve rows;
+	create unlogged table cache (id integer);`
+
+	EXPECT_JSON = `[
+		{
+		  "schema": "",
+		  "name": "scratch1",
+		  "if_not_exists": false,
+		  "temporary": true,
+		  "on_commit": "DROP",
+		  "columns": [
+			{
+			  "name": "id",
+			  "data_type": {
+				"name": "INTEGER",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			}
+		  ],
+		  "constraints": {
+			"primary_key": null,
+			"unique": null,
+			"check": null,
+			"foreign_key": null
+		  }
+		},
+		{
+		  "schema": "",
+		  "name": "scratch2",
+		  "if_not_exists": false,
+		  "temporary": true,
+		  "on_commit": "DELETE ROWS",
+		  "columns": [
+			{
+			  "name": "id",
+			  "data_type": {
+				"name": "INTEGER",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			}
+		  ],
+		  "constraints": {
+			"primary_key": null,
+			"unique": null,
+			"check": null,
+			"foreign_key": null
+		  }
+		},
+		{
+		  "schema": "",
+		  "name": "scratch3",
+		  "if_not_exists": false,
+		  "temporary": true,
+		  "on_commit": "PRESERVE ROWS",
+		  "columns": [
+			{
+			  "name": "id",
+			  "data_type": {
+				"name": "INTEGER",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			}
+		  ],
+		  "constraints": {
+			"primary_key": null,
+			"unique": null,
+			"check": null,
+			"foreign_key": null
+		  }
+		},
+		{
+		  "schema": "",
+		  "name": "cache",
+		  "if_not_exists": false,
+		  "unlogged": true,
+		  "columns": [
+			{
+			  "name": "id",
+			  "data_type": {
+				"name": "INTEGER",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			}
+		  ],
+		  "constraints": {
+			"primary_key": null,
+			"unique": null,
+			"check": null,
+			"foreign_key": null
+		  }
+		}
+	  ]`
+
+	tr.ConvertOK(ddl, EXPECT_JSON)
+
+	ddl = `create table users (
+		id integer generated always as identity primary key,
+		name text not null default 'x' check (name <> ''),
+		org_id integer references orgs (id),
+		unique (name)
+	);
+	create table users_copy (like users);
+	create table users_full (like users including all, note text);
+	create table users_names (uid, uname) as select id, name from users where id in (1, 2) with no data;`
+
+	EXPECT_JSON = `[
+		{
+		  "schema": "",
+		  "name": "users",
+		  "if_not_exists": false,
+		  "columns": [
+			{
+			  "name": "id",
+			  "data_type": {
+				"name": "INTEGER",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": true,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": true,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				},
+				"identity": {
+				  "always": true,
+				  "cycle": false
+				}
+			  }
+			},
+			{
+			  "name": "name",
+			  "data_type": {
+				"name": "TEXT",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": true,
+				"is_autoincrement": false,
+				"default": "x",
+				"default_value": {"kind": "string", "value": "x"},
+				"check": "(name <> '')",
+				"check_expression": {
+				  "source": "name <> ''",
+				  "tree": {
+					"kind": "binary",
+					"op": "<>",
+					"left": {
+					  "kind": "column",
+					  "name": "name"
+					},
+					"right": {
+					  "kind": "string"
+					}
+				  }
+				},
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			},
+			{
+			  "name": "org_id",
+			  "data_type": {
+				"name": "INTEGER",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "orgs",
+				  "column_names": [
+					"id"
+				  ]
+				}
+			  }
+			}
+		  ],
+		  "constraints": {
+			"primary_key": null,
+			"unique": [
+			  {
+				"name": "",
+				"column_names": [
+				  "name"
+				]
+			  }
+			],
+			"check": null,
+			"foreign_key": null
+		  }
+		},
+		{
+		  "schema": "",
+		  "name": "users_copy",
+		  "if_not_exists": false,
+		  "columns": [
+			{
+			  "name": "id",
+			  "data_type": {
+				"name": "INTEGER",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": true,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			},
+			{
+			  "name": "name",
+			  "data_type": {
+				"name": "TEXT",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": true,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			},
+			{
+			  "name": "org_id",
+			  "data_type": {
+				"name": "INTEGER",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			}
+		  ],
+		  "constraints": {
+			"primary_key": null,
+			"unique": null,
+			"check": null,
+			"foreign_key": null
+		  }
+		},
+		{
+		  "schema": "",
+		  "name": "users_full",
+		  "if_not_exists": false,
+		  "columns": [
+			{
+			  "name": "id",
+			  "data_type": {
+				"name": "INTEGER",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": true,
+				"is_unique": false,
+				"is_not_null": true,
+				"is_autoincrement": true,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				},
+				"identity": {
+				  "always": true,
+				  "cycle": false
+				}
+			  }
+			},
+			{
+			  "name": "name",
+			  "data_type": {
+				"name": "TEXT",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": true,
+				"is_autoincrement": false,
+				"default": "x",
+				"default_value": {"kind": "string", "value": "x"},
+				"check": "(name <> '')",
+				"check_expression": {
+				  "source": "name <> ''",
+				  "tree": {
+					"kind": "binary",
+					"op": "<>",
+					"left": {
+					  "kind": "column",
+					  "name": "name"
+					},
+					"right": {
+					  "kind": "string"
+					}
+				  }
+				},
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			},
+			{
+			  "name": "org_id",
+			  "data_type": {
+				"name": "INTEGER",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			},
+			{
+			  "name": "note",
+			  "data_type": {
+				"name": "TEXT",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			}
+		  ],
+		  "constraints": {
+			"primary_key": null,
+			"unique": [
+			  {
+				"name": "",
+				"column_names": [
+				  "name"
+				]
+			  }
+			],
+			"check": null,
+			"foreign_key": null
+		  }
+		},
+		{
+		  "schema": "",
+		  "name": "users_names",
+		  "if_not_exists": false,
+		  "columns": [
+			{
+			  "name": "uid",
+			  "data_type": {
+				"name": "",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			},
+			{
+			  "name": "uname",
+			  "data_type": {
+				"name": "",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			}
+		  ],
+		  "constraints": {
+			"primary_key": null,
+			"unique": null,
+			"check": null,
+			"foreign_key": null
+		  },
+		  "query": "select id, name from users where id in (1, 2)"
+		}
+	  ]`
+
+	tr.ConvertOK(ddl, EXPECT_JSON)
+
+	ddl = `create temp table scratch on commit drop as select id from users;
+	create table archive (uid) with (fillfactor=70) as select id from users;`
+
+	EXPECT_JSON = `[
+		{
+		  "schema": "",
+		  "name": "scratch",
+		  "if_not_exists": false,
+		  "temporary": true,
+		  "on_commit": "DROP",
+		  "columns": [],
+		  "constraints": {
+			"primary_key": null,
+			"unique": null,
+			"check": null,
+			"foreign_key": null
+		  },
+		  "query": "select id from users"
+		},
+		{
+		  "schema": "",
+		  "name": "archive",
+		  "if_not_exists": false,
+		  "columns": [
+			{
+			  "name": "uid",
+			  "data_type": {
+				"name": "",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			}
+		  ],
+		  "constraints": {
+			"primary_key": null,
+			"unique": null,
+			"check": null,
+			"foreign_key": null
+		  },
+		  "query": "select id from users"
+		}
+	  ]`
+
+	tr.ConvertOK(ddl, EXPECT_JSON)
+
+	ddl = `create table users (
+		id integer,
+		name text
+	);
+	comment on table "public".users is 'It''s users';
+	comment on column users.name is 'user name';
+	comment on column users.id is 'id';
+	comment on column users.id is null;
+	comment on table unknown is 'unknown';`
+
+	EXPECT_JSON = `[
+		{
+		  "schema": "",
+		  "name": "users",
+		  "if_not_exists": false,
+		  "comment": "It's users",
+		  "columns": [
+			{
+			  "name": "id",
+			  "data_type": {
+				"name": "INTEGER",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			},
+			{
+			  "name": "name",
+			  "data_type": {
+				"name": "TEXT",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  },
+			  "comment": "user name"
+			}
+		  ],
+		  "constraints": {
+			"primary_key": null,
+			"unique": null,
+			"check": null,
+			"foreign_key": null
+		  }
+		}
+	  ]`
+
+	tr.ConvertOK(ddl, EXPECT_JSON)
+
+	ddl = `create table users (
+		id integer constraint pk_id primary key constraint chk_id check (id > 0),
+		name text constraint nn_name not null constraint df_name default 'a' constraint fk_name references names (name)
+	);`
+
+	EXPECT_JSON = `[
+		{
+		  "schema": "",
+		  "name": "users",
+		  "if_not_exists": false,
+		  "columns": [
+			{
+			  "name": "id",
+			  "data_type": {
+				"name": "INTEGER",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "pk_id",
+				"is_primary_key": true,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "(id > 0)",
+				"check_expression": {
+				  "source": "id > 0",
+				  "tree": {
+					"kind": "binary",
+					"op": ">",
+					"left": {
+					  "kind": "column",
+					  "name": "id"
+					},
+					"right": {
+					  "kind": "number",
+					  "value": "0"
+					}
+				  }
+				},
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				},
+				"primary_key_name": "pk_id",
+				"check_name": "chk_id"
+			  }
+			},
+			{
+			  "name": "name",
+			  "data_type": {
+				"name": "TEXT",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "nn_name",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": true,
+				"is_autoincrement": false,
+				"default": "a",
+				"default_value": {"kind": "string", "value": "a"},
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "names",
+				  "column_names": ["name"]
+				},
+				"not_null_name": "nn_name",
+				"default_name": "df_name",
+				"references_name": "fk_name"
+			  }
+			}
+		  ],
+		  "constraints": {
+			"primary_key": null,
+			"unique": null,
+			"check": null,
+			"foreign_key": null
+		  }
+		}
+	  ]`
+
+	tr.ConvertOK(ddl, EXPECT_JSON)
+}
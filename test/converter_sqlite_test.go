@@ -55,6 +55,7 @@ func TestConvert_SQLite(t *testing.T) {
                   "is_unique": false,
                   "is_not_null": false,
                   "is_autoincrement": true,
+                  "is_rowid_alias": true,
                   "default": null,
                   "check": "",
                   "collate": "",
@@ -100,6 +101,7 @@ func TestConvert_SQLite(t *testing.T) {
                   "is_not_null": true,
                   "is_autoincrement": false,
                   "default": 10,
+                  "default_value": {"kind": "number", "value": "10"},
                   "check": "",
                   "collate": "",
                   "references": {
@@ -110,6 +112,7 @@ func TestConvert_SQLite(t *testing.T) {
               },
               {
                 "name": "column_name4",
+                "name_quoted": true,
                 "data_type": {
                   "name": "NONE",
                   "digit_n": 0,
@@ -167,7 +170,28 @@ func TestConvert_SQLite(t *testing.T) {
                   "is_unique": false,
                   "is_not_null": true,
                   "is_autoincrement": false,
-                  "default": "(DATETIME('now','localtime'))",
+                  "default": "(DATETIME('now', 'localtime'))",
+                  "default_value": {
+                    "kind": "expression",
+                    "value": "(DATETIME('now', 'localtime'))",
+                    "expression": {
+                      "source": "DATETIME('now', 'localtime')",
+                      "tree": {
+                        "kind": "function",
+                        "name": "DATETIME",
+                        "args": [
+                          {
+                            "kind": "string",
+                            "value": "now"
+                          },
+                          {
+                            "kind": "string",
+                            "value": "localtime"
+                          }
+                        ]
+                      }
+                    }
+                  },
                   "check": "",
                   "collate": "",
                   "references": {
@@ -189,7 +213,28 @@ func TestConvert_SQLite(t *testing.T) {
                   "is_unique": false,
                   "is_not_null": true,
                   "is_autoincrement": false,
-                  "default": "(DATETIME('now','localtime'))",
+                  "default": "(DATETIME('now', 'localtime'))",
+                  "default_value": {
+                    "kind": "expression",
+                    "value": "(DATETIME('now', 'localtime'))",
+                    "expression": {
+                      "source": "DATETIME('now', 'localtime')",
+                      "tree": {
+                        "kind": "function",
+                        "name": "DATETIME",
+                        "args": [
+                          {
+                            "kind": "string",
+                            "value": "now"
+                          },
+                          {
+                            "kind": "string",
+                            "value": "localtime"
+                          }
+                        ]
+                      }
+                    }
+                  },
                   "check": "",
                   "collate": "",
                   "references": {
@@ -208,7 +253,9 @@ func TestConvert_SQLite(t *testing.T) {
           },
           {
             "schema": "scm",
+            "schema_quoted": true,
             "name": "table_name2",
+            "name_quoted": true,
             "if_not_exists": false,
             "columns": [
               {
@@ -230,7 +277,8 @@ func TestConvert_SQLite(t *testing.T) {
                   "references": {
                     "table_name": "",
                     "column_names": null
-                  }
+                  },
+                  "primary_key_order": "ASC"
                 }
               },
               {
@@ -252,7 +300,8 @@ func TestConvert_SQLite(t *testing.T) {
                   "references": {
                     "table_name": "",
                     "column_names": null
-                  }
+                  },
+                  "not_null_on_conflict": "FAIL"
                 }
               },
               {
@@ -269,6 +318,7 @@ func TestConvert_SQLite(t *testing.T) {
                   "is_not_null": false,
                   "is_autoincrement": false,
                   "default": -10,
+                  "default_value": {"kind": "number", "value": "-10"},
                   "check": "",
                   "collate": "",
                   "references": {
@@ -291,6 +341,7 @@ func TestConvert_SQLite(t *testing.T) {
                   "is_not_null": false,
                   "is_autoincrement": false,
                   "default": true,
+                  "default_value": {"kind": "boolean", "value": "true"},
                   "check": "",
                   "collate": "",
                   "references": {
@@ -313,6 +364,7 @@ func TestConvert_SQLite(t *testing.T) {
                   "is_not_null": false,
                   "is_autoincrement": false,
                   "default": false,
+                  "default_value": {"kind": "boolean", "value": "false"},
                   "check": "",
                   "collate": "",
                   "references": {
@@ -335,6 +387,7 @@ func TestConvert_SQLite(t *testing.T) {
                   "is_not_null": false,
                   "is_autoincrement": false,
                   "default": null,
+                  "default_value": {"kind": "null"},
                   "check": "",
                   "collate": "",
                   "references": {
@@ -356,7 +409,28 @@ func TestConvert_SQLite(t *testing.T) {
                   "is_unique": false,
                   "is_not_null": false,
                   "is_autoincrement": false,
-                  "default": "(DATETIME('now','localtime'))",
+                  "default": "(DATETIME('now', 'localtime'))",
+                  "default_value": {
+                    "kind": "expression",
+                    "value": "(DATETIME('now', 'localtime'))",
+                    "expression": {
+                      "source": "DATETIME('now', 'localtime')",
+                      "tree": {
+                        "kind": "function",
+                        "name": "DATETIME",
+                        "args": [
+                          {
+                            "kind": "string",
+                            "value": "now"
+                          },
+                          {
+                            "kind": "string",
+                            "value": "localtime"
+                          }
+                        ]
+                      }
+                    }
+                  },
                   "check": "",
                   "collate": "",
                   "references": {
@@ -379,6 +453,7 @@ func TestConvert_SQLite(t *testing.T) {
                   "is_not_null": false,
                   "is_autoincrement": false,
                   "default": "AAA",
+                  "default_value": {"kind": "string", "value": "AAA"},
                   "check": "",
                   "collate": "",
                   "references": {
@@ -402,6 +477,9 @@ func TestConvert_SQLite(t *testing.T) {
                   "is_autoincrement": false,
                   "default": null,
                   "check": "(aaa(aa(a)a())aa)",
+                  "check_expression": {
+                    "source": "aaa(aa(a)a())aa"
+                  },
                   "collate": "",
                   "references": {
                     "table_name": "",
@@ -458,7 +536,12 @@ func TestConvert_SQLite(t *testing.T) {
                   "column_names": [
                     "a",
                     "b",
-                    "\"c\""
+                    "c"
+                  ],
+                  "column_names_quoted": [
+                    false,
+                    false,
+                    true
                   ],
                   "references": {
                     "table_name": "bbb",
@@ -473,5 +556,697 @@ func TestConvert_SQLite(t *testing.T) {
         ]`
 
 	tr.ConvertOK(ddl, EXPECT_JSON)
-}
 
+	ddl = `create table sync_items (
+		id integer primary key desc on conflict replace,
+		code text not null on conflict ignore unique on conflict abort,
+		a integer,
+		b integer,
+		constraint pk_ab primary key (a, b) on conflict rollback,
+		unique (a) on conflict replace
+	);`
+
+	EXPECT_JSON = `[
+          {
+            "schema": "",
+            "name": "sync_items",
+            "if_not_exists": false,
+            "columns": [
+              {
+                "name": "id",
+                "data_type": {
+                  "name": "INTEGER",
+                  "digit_n": 0,
+                  "digit_m": 0
+                },
+                "constraint": {
+                  "name": "",
+                  "is_primary_key": true,
+                  "is_unique": false,
+                  "is_not_null": false,
+                  "is_autoincrement": false,
+                  "default": null,
+                  "check": "",
+                  "collate": "",
+                  "references": {
+                    "table_name": "",
+                    "column_names": null
+                  },
+                  "primary_key_order": "DESC",
+                  "primary_key_on_conflict": "REPLACE"
+                }
+              },
+              {
+                "name": "code",
+                "data_type": {
+                  "name": "TEXT",
+                  "digit_n": 0,
+                  "digit_m": 0
+                },
+                "constraint": {
+                  "name": "",
+                  "is_primary_key": false,
+                  "is_unique": true,
+                  "is_not_null": true,
+                  "is_autoincrement": false,
+                  "default": null,
+                  "check": "",
+                  "collate": "",
+                  "references": {
+                    "table_name": "",
+                    "column_names": null
+                  },
+                  "unique_on_conflict": "ABORT",
+                  "not_null_on_conflict": "IGNORE"
+                }
+              },
+              {
+                "name": "a",
+                "data_type": {
+                  "name": "INTEGER",
+                  "digit_n": 0,
+                  "digit_m": 0
+                },
+                "constraint": {
+                  "name": "",
+                  "is_primary_key": false,
+                  "is_unique": false,
+                  "is_not_null": false,
+                  "is_autoincrement": false,
+                  "default": null,
+                  "check": "",
+                  "collate": "",
+                  "references": {
+                    "table_name": "",
+                    "column_names": null
+                  }
+                }
+              },
+              {
+                "name": "b",
+                "data_type": {
+                  "name": "INTEGER",
+                  "digit_n": 0,
+                  "digit_m": 0
+                },
+                "constraint": {
+                  "name": "",
+                  "is_primary_key": false,
+                  "is_unique": false,
+                  "is_not_null": false,
+                  "is_autoincrement": false,
+                  "default": null,
+                  "check": "",
+                  "collate": "",
+                  "references": {
+                    "table_name": "",
+                    "column_names": null
+                  }
+                }
+              }
+            ],
+            "constraints": {
+              "primary_key": [
+                {
+                  "name": "pk_ab",
+                  "column_names": [
+                    "a",
+                    "b"
+                  ],
+                  "on_conflict": "ROLLBACK"
+                }
+              ],
+              "unique": [
+                {
+                  "name": "",
+                  "column_names": [
+                    "a"
+                  ],
+                  "on_conflict": "REPLACE"
+                }
+              ],
+              "check": null,
+              "foreign_key": null
+            }
+          }
+        ]`
+
+	tr.ConvertOK(ddl, EXPECT_JSON)
+
+	ddl = `create table t1 (id integer primary key);
+	create table t2 (id int primary key);
+	create table t3 (id integer primary key desc);
+	create table t4 (id integer, primary key ("id"));
+	create table t5 (id integer primary key) without rowid;`
+
+	EXPECT_JSON = `[
+          {
+            "schema": "",
+            "name": "t1",
+            "if_not_exists": false,
+            "columns": [
+              {
+                "name": "id",
+                "data_type": {
+                  "name": "INTEGER",
+                  "digit_n": 0,
+                  "digit_m": 0
+                },
+                "constraint": {
+                  "name": "",
+                  "is_primary_key": true,
+                  "is_unique": false,
+                  "is_not_null": false,
+                  "is_autoincrement": false,
+                  "is_rowid_alias": true,
+                  "default": null,
+                  "check": "",
+                  "collate": "",
+                  "references": {
+                    "table_name": "",
+                    "column_names": null
+                  }
+                }
+              }
+            ],
+            "constraints": {
+              "primary_key": null,
+              "unique": null,
+              "check": null,
+              "foreign_key": null
+            }
+          },
+          {
+            "schema": "",
+            "name": "t2",
+            "if_not_exists": false,
+            "columns": [
+              {
+                "name": "id",
+                "data_type": {
+                  "name": "INT",
+                  "digit_n": 0,
+                  "digit_m": 0
+                },
+                "constraint": {
+                  "name": "",
+                  "is_primary_key": true,
+                  "is_unique": false,
+                  "is_not_null": false,
+                  "is_autoincrement": false,
+                  "default": null,
+                  "check": "",
+                  "collate": "",
+                  "references": {
+                    "table_name": "",
+                    "column_names": null
+                  }
+                }
+              }
+            ],
+            "constraints": {
+              "primary_key": null,
+              "unique": null,
+              "check": null,
+              "foreign_key": null
+            }
+          },
+          {
+            "schema": "",
+            "name": "t3",
+            "if_not_exists": false,
+            "columns": [
+              {
+                "name": "id",
+                "data_type": {
+                  "name": "INTEGER",
+                  "digit_n": 0,
+                  "digit_m": 0
+                },
+                "constraint": {
+                  "name": "",
+                  "is_primary_key": true,
+                  "is_unique": false,
+                  "is_not_null": false,
+                  "is_autoincrement": false,
+                  "default": null,
+                  "check": "",
+                  "collate": "",
+                  "references": {
+                    "table_name": "",
+                    "column_names": null
+                  },
+                  "primary_key_order": "DESC"
+                }
+              }
+            ],
+            "constraints": {
+              "primary_key": null,
+              "unique": null,
+              "check": null,
+              "foreign_key": null
+            }
+          },
+          {
+            "schema": "",
+            "name": "t4",
+            "if_not_exists": false,
+            "columns": [
+              {
+                "name": "id",
+                "data_type": {
+                  "name": "INTEGER",
+                  "digit_n": 0,
+                  "digit_m": 0
+                },
+                "constraint": {
+                  "name": "",
+                  "is_primary_key": false,
+                  "is_unique": false,
+                  "is_not_null": false,
+                  "is_autoincrement": false,
+                  "is_rowid_alias": true,
+                  "default": null,
+                  "check": "",
+                  "collate": "",
+                  "references": {
+                    "table_name": "",
+                    "column_names": null
+                  }
+                }
+              }
+            ],
+            "constraints": {
+              "primary_key": [
+                {
+                  "name": "",
+                  "column_names": [
+                    "id"
+                  ],
+                  "column_names_quoted": [
+                    true
+                  ]
+                }
+              ],
+              "unique": null,
+              "check": null,
+              "foreign_key": null
+            }
+          },
+          {
+            "schema": "",
+            "name": "t5",
+            "if_not_exists": false,
+            "columns": [
+              {
+                "name": "id",
+                "data_type": {
+                  "name": "INTEGER",
+                  "digit_n": 0,
+                  "digit_m": 0
+                },
+                "constraint": {
+                  "name": "",
+                  "is_primary_key": true,
+                  "is_unique": false,
+                  "is_not_null": false,
+                  "is_autoincrement": false,
+                  "default": null,
+                  "check": "",
+                  "collate": "",
+                  "references": {
+                    "table_name": "",
+                    "column_names": null
+                  }
+                }
+              }
+            ],
+            "constraints": {
+              "primary_key": null,
+              "unique": null,
+              "check": null,
+              "foreign_key": null
+            },
+            "without_rowid": true
+          }
+        ]`
+
+	tr.ConvertOK(ddl, EXPECT_JSON)
+
+	ddl = `create temp table scratch (id text);`
+
+	EXPECT_JSON = `[
+		{
+		  "schema": "",
+		  "name": "scratch",
+		  "if_not_exists": false,
+		  "temporary": true,
+		  "columns": [
+			{
+			  "name": "id",
+			  "data_type": {
+				"name": "TEXT",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				}
+			  }
+			}
+		  ],
+		  "constraints": {
+			"primary_key": null,
+			"unique": null,
+			"check": null,
+			"foreign_key": null
+		  }
+		}
+	  ]`
+
+	tr.ConvertOK(ddl, EXPECT_JSON)
+
+
+	ddl = `create table users (id integer primary key, name text);
+	create table users_names as select id, name from users;`
+
+	EXPECT_JSON = `[
+          {
+            "schema": "",
+            "name": "users",
+            "if_not_exists": false,
+            "columns": [
+              {
+                "name": "id",
+                "data_type": {
+                  "name": "INTEGER",
+                  "digit_n": 0,
+                  "digit_m": 0
+                },
+                "constraint": {
+                  "name": "",
+                  "is_primary_key": true,
+                  "is_unique": false,
+                  "is_not_null": false,
+                  "is_autoincrement": false,
+                  "is_rowid_alias": true,
+                  "default": null,
+                  "check": "",
+                  "collate": "",
+                  "references": {
+                    "table_name": "",
+                    "column_names": null
+                  }
+                }
+              },
+              {
+                "name": "name",
+                "data_type": {
+                  "name": "TEXT",
+                  "digit_n": 0,
+                  "digit_m": 0
+                },
+                "constraint": {
+                  "name": "",
+                  "is_primary_key": false,
+                  "is_unique": false,
+                  "is_not_null": false,
+                  "is_autoincrement": false,
+                  "default": null,
+                  "check": "",
+                  "collate": "",
+                  "references": {
+                    "table_name": "",
+                    "column_names": null
+                  }
+                }
+              }
+            ],
+            "constraints": {
+              "primary_key": null,
+              "unique": null,
+              "check": null,
+              "foreign_key": null
+            }
+          },
+          {
+            "schema": "",
+            "name": "users_names",
+            "if_not_exists": false,
+            "columns": [],
+            "constraints": {
+              "primary_key": null,
+              "unique": null,
+              "check": null,
+              "foreign_key": null
+            },
+            "query": "select id, name from users"
+          }
+        ]`
+
+	tr.ConvertOK(ddl, EXPECT_JSON)
+
+	ddl = `create table users (
+		id integer constraint pk_id primary key constraint chk_id check (id > 0),
+		name text constraint nn_name not null constraint df_name default 'a' constraint fk_name references names (name)
+	);`
+
+	EXPECT_JSON = `[
+		{
+		  "schema": "",
+		  "name": "users",
+		  "if_not_exists": false,
+		  "columns": [
+			{
+			  "name": "id",
+			  "data_type": {
+				"name": "INTEGER",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "pk_id",
+				"is_primary_key": true,
+				"is_unique": false,
+				"is_not_null": false,
+				"is_autoincrement": false,
+				"default": null,
+				"check": "(id > 0)",
+				"check_expression": {
+				  "source": "id > 0",
+				  "tree": {
+					"kind": "binary",
+					"op": ">",
+					"left": {
+					  "kind": "column",
+					  "name": "id"
+					},
+					"right": {
+					  "kind": "number",
+					  "value": "0"
+					}
+				  }
+				},
+				"collate": "",
+				"references": {
+				  "table_name": "",
+				  "column_names": null
+				},
+				"primary_key_name": "pk_id",
+				"check_name": "chk_id",
+				"is_rowid_alias": true
+			  }
+			},
+			{
+			  "name": "name",
+			  "data_type": {
+				"name": "TEXT",
+				"digit_n": 0,
+				"digit_m": 0
+			  },
+			  "constraint": {
+				"name": "nn_name",
+				"is_primary_key": false,
+				"is_unique": false,
+				"is_not_null": true,
+				"is_autoincrement": false,
+				"default": "a",
+				"default_value": {"kind": "string", "value": "a"},
+				"check": "",
+				"collate": "",
+				"references": {
+				  "table_name": "names",
+				  "column_names": ["name"]
+				},
+				"not_null_name": "nn_name",
+				"default_name": "df_name",
+				"references_name": "fk_name"
+			  }
+			}
+		  ],
+		  "constraints": {
+			"primary_key": null,
+			"unique": null,
+			"check": null,
+			"foreign_key": null
+		  }
+		}
+	  ]`
+
+	tr.ConvertOK(ddl, EXPECT_JSON)
+
+	ddl = `create table t1 (id integer, primary key (id desc));
+	create table t2 (x text, y text, primary key (x, y asc));`
+
+	EXPECT_JSON = `[
+	  {
+		"schema": "",
+		"name": "t1",
+		"if_not_exists": false,
+		"columns": [
+		  {
+			"name": "id",
+			"data_type": {
+			  "name": "INTEGER",
+			  "digit_n": 0,
+			  "digit_m": 0
+			},
+			"constraint": {
+			  "name": "",
+			  "is_primary_key": false,
+			  "is_unique": false,
+			  "is_not_null": false,
+			  "is_autoincrement": false,
+			  "is_rowid_alias": true,
+			  "default": null,
+			  "check": "",
+			  "collate": "",
+			  "references": {
+				"table_name": "",
+				"column_names": null
+			  }
+			}
+		  }
+		],
+		"constraints": {
+		  "primary_key": [
+			{
+			  "name": "",
+			  "column_names": [
+				"id"
+			  ],
+			  "column_orders": [
+				"DESC"
+			  ]
+			}
+		  ],
+		  "unique": null,
+		  "check": null,
+		  "foreign_key": null
+		}
+	  },
+	  {
+		"schema": "",
+		"name": "t2",
+		"if_not_exists": false,
+		"columns": [
+		  {
+			"name": "x",
+			"data_type": {
+			  "name": "TEXT",
+			  "digit_n": 0,
+			  "digit_m": 0
+			},
+			"constraint": {
+			  "name": "",
+			  "is_primary_key": false,
+			  "is_unique": false,
+			  "is_not_null": false,
+			  "is_autoincrement": false,
+			  "default": null,
+			  "check": "",
+			  "collate": "",
+			  "references": {
+				"table_name": "",
+				"column_names": null
+			  }
+			}
+		  },
+		  {
+			"name": "y",
+			"data_type": {
+			  "name": "TEXT",
+			  "digit_n": 0,
+			  "digit_m": 0
+			},
+			"constraint": {
+			  "name": "",
+			  "is_primary_key": false,
+			  "is_unique": false,
+			  "is_not_null": false,
+			  "is_autoincrement": false,
+			  "default": null,
+			  "check": "",
+			  "collate": "",
+			  "references": {
+				"table_name": "",
+				"column_names": null
+			  }
+			}
+		  }
+		],
+		"constraints": {
+		  "primary_key": [
+			{
+			  "name": "",
+			  "column_names": [
+				"x",
+				"y"
+			  ],
+			  "column_orders": [
+				"",
+				"ASC"
+			  ]
+			}
+		  ],
+		  "unique": null,
+		  "check": null,
+		  "foreign_key": null
+		}
+	  }
+	]`
+
+	tr.ConvertOK(ddl, EXPECT_JSON)
+
+	ddl = `create virtual table if not exists docs using fts5(title, body, tokenize = 'porter ascii');`
+
+	EXPECT_JSON = `[
+		{
+		  "schema": "",
+		  "name": "docs",
+		  "if_not_exists": true,
+		  "columns": [],
+		  "constraints": {
+			"primary_key": null,
+			"unique": null,
+			"check": null,
+			"foreign_key": null
+		  },
+		  "virtual": true,
+		  "module": "fts5",
+		  "module_arguments": ["title", "body", "tokenize = 'porter ascii'"]
+		}
+	  ]`
+
+	tr.ConvertOK(ddl, EXPECT_JSON)
+}
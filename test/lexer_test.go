@@ -22,6 +22,15 @@ func TestLex_SQLite(t *testing.T) {
 		);` + "CREATE TABLE IF NOT EXISTS users (`user_id` INTEGER PRIMARY KEY AUTOINCREMENT)"
 
 	tr.LexOK(ddl, 85)
+
+	ddl = `price NUMERIC DEFAULT 0.10, rate REAL DEFAULT -1.5, main.t1.c`
+	tr.LexOK(ddl, 15)
+
+	ddl = `CHECK(a>=0 AND b<>-1 AND c||'x'=d)`
+	tr.LexOK(ddl, 16)
+
+	ddl = `CREATE TABLE [user list] ([id] INTEGER, [a"b] TEXT)`
+	tr.LexOK(ddl, 10)
 	
 	ddl = `CREATE TABLE IF NOT EXISTS users (
 		"user_id" INTEGER PRIMARY KEY AUTOINCREMENT,
@@ -49,6 +58,9 @@ func TestLex_SQLite(t *testing.T) {
 
 	ddl = "CREATE TABLE IF NOT EXISTS `users ();"
 	tr.LexNG(ddl, 1, "<EOF>")
+
+	ddl = "CREATE TABLE IF NOT EXISTS [users ();"
+	tr.LexNG(ddl, 1, "<EOF>")
 }
 
 
@@ -83,4 +95,12 @@ func TestLex_PostgreSQL(t *testing.T) {
 
 	tr.LexNG(ddl, 8, "`")
 
+	ddl = `CREATE FUNCTION f() RETURNS text AS $$ SELECT 'a;' $$;` +
+		`CREATE TABLE t (a text[], b int[3][], c text DEFAULT $t$x$$y$t$, d int DEFAULT $1);`
+	tr.LexOK(ddl, 34)
+
+	ddl = `CREATE FUNCTION f() RETURNS text AS $body$
+	SELECT 1;`
+	tr.LexNG(ddl, 2, "<EOF>")
+
 }
\ No newline at end of file
@@ -0,0 +1,70 @@
+package test
+
+import (
+	"os"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/kodaimura/ddlparse/internal/sqlitefile"
+)
+
+
+/*
+  testdata/rollback.db: page size 512 (page 1 is an interior page and
+    the CREATE TABLE of "wide" spans overflow pages), rollback journal.
+  testdata/wal.db: page size 1024, UTF-16le, WAL (checkpointed).
+*/
+func TestReadSQLiteFile(t *testing.T) {
+	rows, err := sqlitefile.ReadFile("testdata/rollback.db")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 33 {
+		t.Fatalf("failed: %d rows", len(rows))
+	}
+	wide := rows[0]
+	if wide.Type != "table" || wide.Name != "wide" || len(wide.SQL) != 1172 ||
+		!strings.HasPrefix(wide.SQL, "CREATE TABLE wide (") || !strings.HasSuffix(wide.SQL, "'value 19'\n)") {
+		t.Errorf("failed: %v", wide)
+	}
+	last := rows[len(rows) - 1]
+	if last.Type != "view" || last.Name != "v" || last.SQL != "CREATE VIEW v AS SELECT id FROM wide" {
+		t.Errorf("failed: %v", last)
+	}
+	autoindexes := 0
+	for _, row := range rows {
+		if row.Type == "index" && row.SQL == "" {
+			autoindexes += 1
+		}
+	}
+	if autoindexes != 10 {
+		t.Errorf("failed: %d autoindexes", autoindexes)
+	}
+
+	rows, err = sqlitefile.ReadFile("testdata/wal.db")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 4 || rows[0].Name != "ユーザー" || rows[1].Name != "idx_名前" || rows[1].TblName != "ユーザー" ||
+		rows[0].SQL != `CREATE TABLE "ユーザー" (id integer primary key, "名前" text not null)` {
+		t.Errorf("failed: %v", rows)
+	}
+
+	data, err := os.ReadFile("testdata/rollback.db")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sqlitefile.Read(bytes.NewReader(data[:4096])); err == nil {
+		t.Errorf("failed: truncated file is accepted")
+	}
+	if _, err := sqlitefile.Read(bytes.NewReader([]byte("CREATE TABLE users (id integer);"))); err == nil {
+		t.Errorf("failed: not a SQLite database is accepted")
+	}
+	if _, err := sqlitefile.ReadFile("testdata/none.db"); err == nil {
+		t.Errorf("failed: missing file is accepted")
+	}
+	if rows, err := sqlitefile.Read(bytes.NewReader([]byte{})); err != nil || len(rows) != 0 {
+		t.Errorf("failed: empty database: %v, %v", rows, err)
+	}
+}
@@ -57,7 +57,7 @@ func TestValidate_MySQL(t *testing.T) {
 		aaaa int,
 		aaaa integer -comment
 	);`
-	tr.ValidateNG(ddl, 3, "-comment")
+	tr.ValidateNG(ddl, 3, "-")
 
 	ddl = `create table users (
 		aaaa int,
@@ -188,10 +188,46 @@ func TestValidate_MySQL(t *testing.T) {
 		aaaa multilinestring,
 		aaaa multipolygon,
 		aaaa geometrycollection,
-		aaaa json
+		aaaa json,
+		aaaa int unsigned,
+		aaaa int signed,
+		aaaa bigint(20) unsigned zerofill,
+		aaaa tinyint(3) zerofill,
+		aaaa decimal(10, 2) unsigned,
+		aaaa double unsigned zerofill,
+		aaaa varchar(100) character set utf8mb4 collate utf8mb4_bin,
+		aaaa char(10) charset latin1,
+		aaaa text character set utf8mb4,
+		aaaa tinytext,
+		aaaa mediumtext charset utf8mb4,
+		aaaa longtext character set utf8mb4 not null
 	);`
 	tr.ValidateOK(ddl)
 
+	ddl = `create table users (
+		aaaa int,
+		aaaa int signed unsigned
+	);`
+	tr.ValidateNG(ddl, 3, "unsigned")
+
+	ddl = `create table users (
+		aaaa int,
+		aaaa varchar(10) unsigned
+	);`
+	tr.ValidateNG(ddl, 3, "unsigned")
+
+	ddl = `create table users (
+		aaaa int,
+		aaaa int character set utf8mb4
+	);`
+	tr.ValidateNG(ddl, 3, "character")
+
+	ddl = `create table users (
+		aaaa int,
+		aaaa varchar(10) character utf8mb4
+	);`
+	tr.ValidateNG(ddl, 3, "utf8mb4")
+
 	ddl = `create table users (
 		aaaa int,
 		aaaa bigin
@@ -495,6 +531,10 @@ func TestValidate_MySQL(t *testing.T) {
 		aaaa time default current_time on update current_time,
 		aaaa timestamp default current_timestamp,
 		aaaa timestamp default current_timestamp on update current_timestamp,
+		aaaa timestamp(6) default current_timestamp(6) on update current_timestamp(6),
+		aaaa datetime default now() on update now(),
+		aaaa datetime(3) null on update localtimestamp(3),
+		aaaa timestamp on update current_timestamp not null default current_timestamp,
 		aaaa integer default (expr(aaa)),
 		aaaa integer visible,
 		aaaa integer invisible,
@@ -575,6 +615,71 @@ func TestValidate_MySQL(t *testing.T) {
 	);`
 	tr.ValidateNG(ddl, 2, "primary")
 
+	ddl = `create table users (
+		aaaa timestamp on update 'aaa'
+	);`
+	tr.ValidateNG(ddl, 2, "'aaa'")
+
+	ddl = `create table users (
+		aaaa timestamp default current_timestamp(7)
+	);`
+	tr.ValidateNG(ddl, 2, "7")
+
+	ddl = `create table users (
+		aaaa timestamp on update current_timestamp on update current_timestamp
+	);`
+	tr.ValidateNG(ddl, 2, "on")
+
+	ddl = `create table users (
+		aaaa timestamp on delete current_timestamp
+	);`
+	tr.ValidateNG(ddl, 2, "delete")
+
+	ddl = `create table users (
+		id int primary key constraint chk_id check (id > 0)
+	);`
+	tr.ValidateOK(ddl)
+
+	ddl = `create table users (
+		id int not null constraint pk_id primary key
+	);`
+	tr.ValidateNG(ddl, 2, "primary")
+
+	/* -------------------------------------------------- */
+	fmt.Println("Temporary Table")
+	ddl = `create temporary table users (
+		aaaa integer
+	);
+	CREATE TEMPORARY TABLE IF NOT EXISTS users (
+		aaaa integer
+	) ENGINE = InnoDB;`
+	tr.ValidateOK(ddl)
+
+	ddl = `create temp table users (
+		aaaa integer
+	);`
+	tr.ValidateNG(ddl, 1, "temp")
+
+	ddl = `create temporary view v as select 1;`
+	tr.ValidateNG(ddl, 1, "view")
+
+	/* -------------------------------------------------- */
+	fmt.Println("Create Table As / Like")
+	ddl = `create table archive as select * from users where id > 1;
+	create table archive2 select id, name from users;
+	create table archive3 like users;
+	create table archive4 (like scm.users);`
+	tr.ValidateOK(ddl)
+
+	ddl = `create table archive as insert into users values (1);`
+	tr.ValidateNG(ddl, 1, "insert")
+
+	ddl = `create table archive like;`
+	tr.ValidateNG(ddl, 1, ";")
+
+	ddl = `create table archive (like users including all);`
+	tr.ValidateNG(ddl, 1, "including")
+
 	/* -------------------------------------------------- */
 	fmt.Println("Create Other Than Table")
 	ddl = `create table scm.users (
@@ -615,5 +720,52 @@ func TestValidate_MySQL(t *testing.T) {
 	END;`
 	tr.ValidateNG(ddl, 5, "TRIGGE")
 
+	/* -------------------------------------------------- */
+	ddl = `create database if not exists shop default character set utf8mb4 collate = utf8mb4_bin encryption = 'N';
+	create schema logs charset latin1 read only default;
+	use shop;
+	create table users (
+		aaaa integer
+	);`
+	tr.ValidateOK(ddl)
+
+	ddl = `use;`
+	tr.ValidateNG(ddl, 1, ";")
+
+	ddl = `create database shop character utf8mb4;`
+	tr.ValidateNG(ddl, 1, "utf8mb4")
+
+	/* -------------------------------------------------- */
+	ddl = `drop temporary table if exists users, scm.orders restrict;
+	drop view if exists v1, v2 cascade;
+	drop database if exists shop;
+	drop index uq_email on users algorithm = inplace lock default;
+	drop trigger if exists trg;
+	create table users (
+		aaaa integer
+	);`
+	tr.ValidateOK(ddl)
+
+	ddl = `drop temporary view v1;`
+	tr.ValidateNG(ddl, 1, "view")
+
+	ddl = `drop index uq_email;`
+	tr.ValidateNG(ddl, 1, ";")
+
+	/* -------------------------------------------------- */
+	ddl = `create table users (
+		key idx_name (name(10)) using btree,
+		name varchar(50),
+		fulltext key ft_name (name),
+		spatial index (name)
+	);`
+	tr.ValidateOK(ddl)
+
+	ddl = `lock tables users write;`
+	tr.ValidateNG(ddl, 1, "lock")
+
+	ddl = `/*!40101 set names utf8mb4 */;`
+	tr.ValidateNG(ddl, 1, ";")
+
 	/* -------------------------------------------------- */
 }
\ No newline at end of file
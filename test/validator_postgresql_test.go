@@ -57,7 +57,7 @@ func TestValidate_PostgreSQL(t *testing.T) {
 		aaaa int,
 		aaaa integer -comment
 	);`
-	tr.ValidateNG(ddl, 3, "-comment")
+	tr.ValidateNG(ddl, 3, "-")
 
 	ddl = `create table users (
 		aaaa int,
@@ -346,12 +346,16 @@ func TestValidate_PostgreSQL(t *testing.T) {
 		unique(aaaa) include (bbbb, cccc),
 		unique(aaaa) with (aaaa = value, bbbb = 1),
 		unique(aaaa) using index tablespace tsn,
+		unique(aaaa) with (fillfactor=70) using index tablespace tsn,
+		unique nulls distinct (aaaa),
+		unique nulls not distinct (aaaa, bbbb) include (cccc),
 		constraint constraint_zzzz primary key(aaaa),
 		primary key(aaaa),
 		primary key(aaaa, bbbb, "cccc"),
 		primary key(aaaa) include (bbbb, cccc),
 		primary key(aaaa) with (aaaa = value, bbbb = 1),
 		primary key(aaaa) using index tablespace tsn,
+		primary key(aaaa) include (bbbb) with (fillfactor=70, deduplicate_items = off) using index tablespace tsn,
 		constraint constraint_zzzz exclude (exclude_element WITH operator, exclude_element WITH operator),
 		exclude (exclude_element WITH operator, exclude_element WITH operator),
 		exclude using index_method (exclude_element WITH operator),
@@ -418,6 +422,30 @@ func TestValidate_PostgreSQL(t *testing.T) {
 	);`
 	tr.ValidateNG(ddl, 3, "'aaaa'")
 
+	ddl = `create table users (
+		aaaa integer,
+		unique nulls (aaaa)
+	);`
+	tr.ValidateNG(ddl, 3, "(")
+
+	ddl = `create table users (
+		aaaa integer,
+		unique (aaaa) nulls not distinct
+	);`
+	tr.ValidateNG(ddl, 3, "nulls")
+
+	ddl = `create table users (
+		aaaa integer,
+		primary key (aaaa) with ()
+	);`
+	tr.ValidateNG(ddl, 3, ")")
+
+	ddl = `create table users (
+		aaaa integer,
+		primary key (aaaa) with (fillfactor=70,)
+	);`
+	tr.ValidateNG(ddl, 3, ")")
+
 	/* -------------------------------------------------- */
 	fmt.Println("Column Constraints");
 	ddl = `create table users (
@@ -438,11 +466,13 @@ func TestValidate_PostgreSQL(t *testing.T) {
 		aaaa integer constraint constraint_zzzz generated always as (generation_expr) stored,
 		aaaa integer generated always as (generation_expr) stored,
 		aaaa integer generated as identity,
-		aaaa integer generated as identity (sequence_options),
+		aaaa integer generated as identity (start with 1),
 		aaaa integer generated always as identity,
-		aaaa integer generated always as identity (sequence_options),
+		aaaa integer generated always as identity (start with 100 increment by 10),
 		aaaa integer generated by default as identity,
-		aaaa integer generated by default as identity (sequence_options),
+		aaaa integer generated by default as identity (start 1 increment 1 minvalue -10 maxvalue 1000 cache 5 cycle),
+		aaaa integer generated by default as identity (as bigint no minvalue no maxvalue no cycle),
+		aaaa integer generated always as identity (sequence name aaaa_seq start with 1),
 		aaaa integer constraint constraint_zzzz check(aaa),
 		aaaa integer check(aaa()'bbb'(aaa)),
 		aaaa integer check(aaa) no inherit,
@@ -451,6 +481,8 @@ func TestValidate_PostgreSQL(t *testing.T) {
 		aaaa integer unique include (bbbb, cccc),
 		aaaa integer unique with (aaaa = value, bbbb = 1),
 		aaaa integer unique using index tablespace tsn,
+		aaaa integer unique nulls not distinct,
+		aaaa integer unique nulls distinct with (fillfactor=70),
 		aaaa integer constraint constraint_zzzz primary key,
 		aaaa integer primary key,
 		aaaa integer primary key include (bbbb, cccc),
@@ -474,6 +506,21 @@ func TestValidate_PostgreSQL(t *testing.T) {
 	);`
 	tr.ValidateNG(ddl, 2, "not")
 
+	ddl = `create table users (
+		aaaa integer generated always as identity (start with aaa)
+	);`
+	tr.ValidateNG(ddl, 2, "aaa")
+
+	ddl = `create table users (
+		aaaa integer generated always as identity (sequence_options)
+	);`
+	tr.ValidateNG(ddl, 2, "sequence_options")
+
+	ddl = `create table users (
+		aaaa integer generated by default as identity (no start)
+	);`
+	tr.ValidateNG(ddl, 2, "start")
+
 	ddl = `create table users (
 		aaaa integer not null null
 	);`
@@ -504,11 +551,110 @@ func TestValidate_PostgreSQL(t *testing.T) {
 	);`
 	tr.ValidateNG(ddl, 2, "unique")
 
+	ddl = `create table users (
+		aaaa integer unique nulls not
+	);`
+	tr.ValidateNG(ddl, 3, ")")
+
 	ddl = `create table users (
 		aaaa integer primary key primary key
 	);`
 	tr.ValidateNG(ddl, 2, "primary")
 
+	ddl = `create table users (
+		id integer constraint pk_id primary key constraint chk_id check (id > 0),
+		name text constraint nn_name not null constraint df_name default 'a' constraint fk_name references names (name)
+	);`
+	tr.ValidateOK(ddl)
+
+	ddl = `create table users (
+		id integer constraint pk_id primary key constraint chk_id
+	);`
+	tr.ValidateNG(ddl, 3, ")")
+
+	/* -------------------------------------------------- */
+	fmt.Println("Temporary Table")
+	ddl = `create temporary table users (
+		aaaa integer
+	);
+	create temp table users (
+		aaaa integer
+	) on commit drop;
+	create global temporary table users (
+		aaaa integer
+	) on commit delete rows;
+	create local temp table users (
+		aaaa integer
+	) with (fillfactor=70) on commit preserve rows tablespace tsn;
+	create unlogged table users (
+		aaaa integer
+	);
+	create temp sequence seq;
+	create temp view v as select 1;`
+	tr.ValidateOK(ddl)
+
+	ddl = `create global table users (
+		aaaa integer
+	);`
+	tr.ValidateNG(ddl, 1, "table")
+
+	ddl = `create unlogged index idx on users (aaaa);`
+	tr.ValidateNG(ddl, 1, "index")
+
+	ddl = `create temp table users (
+		aaaa integer
+	) on commit delete;`
+	tr.ValidateNG(ddl, 3, ";")
+
+	ddl = `create temp table users (
+		aaaa integer
+	) on commit truncate;`
+	tr.ValidateNG(ddl, 3, "truncate")
+
+	ddl = `create table users (
+		aaaa integer
+	) on commit drop;`
+	tr.ValidateNG(ddl, 3, "on")
+
+	ddl = `create unlogged table users (
+		aaaa integer
+	) on commit delete rows;`
+	tr.ValidateNG(ddl, 3, "on")
+
+	ddl = `create table archive on commit drop as select 1;`
+	tr.ValidateNG(ddl, 1, "on")
+
+	/* -------------------------------------------------- */
+	fmt.Println("Create Table As / Like")
+	ddl = `create table archive as select * from users where id > 1;
+	create table archive2 (aaaa, "bbbb") as select id, name from users with no data;
+	create temp table archive3 as
+		with x as (select 1 as a) select * from x
+		with data;
+	create table archive4 (like users);
+	create table archive5 (like scm.users including all excluding indexes);
+	create table archive6 (
+		aaaa integer,
+		like users including defaults including constraints,
+		bbbb text
+	);
+	create temp table archive7 on commit drop as select 1;
+	create table archive8 (aaaa, bbbb) with (fillfactor=70) as select 1, 2;
+	create table archive9 using heap without oids tablespace tsn as select 1;`
+	tr.ValidateOK(ddl)
+
+	ddl = `create table archive as insert into users values (1);`
+	tr.ValidateNG(ddl, 1, "insert")
+
+	ddl = `create table archive as select (1;`
+	tr.ValidateNG(ddl, 1, "<EOF>")
+
+	ddl = `create table archive (aaaa integer) as select 1;`
+	tr.ValidateNG(ddl, 1, "integer")
+
+	ddl = `create table archive (like users including everything);`
+	tr.ValidateNG(ddl, 1, "everything")
+
 	/* -------------------------------------------------- */
 	fmt.Println("Create Other Than Table")
 	ddl = `create table scm.users (
@@ -552,5 +698,166 @@ func TestValidate_PostgreSQL(t *testing.T) {
 	END;`
 	tr.ValidateNG(ddl, 5, "TRIGGE")
 
+	/* -------------------------------------------------- */
+	fmt.Println("Sequence")
+	ddl = `create sequence users_id_seq;
+	create sequence if not exists scm.users_id_seq
+		as bigint
+		increment by 1
+		minvalue 1
+		no maxvalue
+		start with 1
+		cache 1
+		no cycle
+		owned by scm.users.id;
+	create sequence users_id_seq increment 10 start 100 maxvalue 1000 cycle owned by none;
+	alter sequence users_id_seq owned by users.id;
+	alter sequence if exists users_id_seq restart with 10 increment by 2;
+
+	create table users (
+		aaaa integer default nextval('users_id_seq'::regclass),
+		aaaa integer default nextval('users_id_seq'),
+		aaaa integer not null default nextval('scm.users_id_seq'::regclass)
+	);`
+	tr.ValidateOK(ddl)
+
+	ddl = `create sequence users_id_seq start with aaa;`
+	tr.ValidateNG(ddl, 1, "aaa")
+
+	ddl = `create sequence users_id_seq owned by users;`
+	tr.ValidateNG(ddl, 1, ";")
+
+	ddl = `create sequence users_id_seq
+		sequence_options;`
+	tr.ValidateNG(ddl, 2, "sequence_options")
+
+	ddl = `alter sequence users_id_seq restart with aaa;`
+	tr.ValidateNG(ddl, 1, "aaa")
+
+	ddl = `alter table users owner to aaa;`
+	tr.ValidateOK(ddl)
+
+	ddl = `alter table users rename to aaa;`
+	tr.ValidateNG(ddl, 1, "rename")
+
+	ddl = `create table users (
+		aaaa integer default nextval 'users_id_seq'
+	);`
+	tr.ValidateNG(ddl, 2, "nextval")
+
+	/* -------------------------------------------------- */
+	ddl = `create table scm.users (
+		id integer primary key,
+		name text
+	);
+	comment on table scm.users is 'users';
+	comment on column scm.users.name is 'user''s name';
+	comment on column users.id is null;
+	comment on index users_pkey is 'index';
+	comment on function f(integer) is null;`
+	tr.ValidateOK(ddl)
+
+	ddl = `comment on table users is aaa;`
+	tr.ValidateNG(ddl, 1, "aaa")
+
+	ddl = `comment on column users is 'name';`
+	tr.ValidateNG(ddl, 1, "is")
+
+	ddl = `comment on index users_pkey 'index';`
+	tr.ValidateNG(ddl, 1, ";")
+
+	/* -------------------------------------------------- */
+	ddl = `create schema if not exists app authorization admin;
+	create schema authorization current_user;
+	create schema hollywood
+		create table films (title text);
+	set client_encoding = 'UTF8';
+	set search_path to "$user", app, public;
+	set local search_path = 'app';
+	set search_path to default;
+	create table public.users (
+		aaaa integer
+	);`
+	tr.ValidateOK(ddl)
+
+	ddl = `set search_path app;`
+	tr.ValidateNG(ddl, 1, "app")
+
+	ddl = `create schema;`
+	tr.ValidateNG(ddl, 1, ";")
+
+	/* -------------------------------------------------- */
+	ddl = `drop table if exists users, scm.orders cascade;
+	drop sequence users_id_seq restrict;
+	drop schema if exists app, app2 cascade;
+	drop index concurrently if exists idx_users;
+	drop materialized view mv;
+	create table users (
+		aaaa integer
+	);`
+	tr.ValidateOK(ddl)
+
+	ddl = `drop table users cascade restrict;`
+	tr.ValidateNG(ddl, 1, "restrict")
+
+	ddl = `drop table if users;`
+	tr.ValidateNG(ddl, 1, "users")
+
+	ddl = `drop users;`
+	tr.ValidateNG(ddl, 1, "users")
+
+	/* -------------------------------------------------- */
+	ddl = `create table users (
+		id integer not null,
+		status character varying(20) default 'active'::character varying not null,
+		tags text[] default '{}'::text[],
+		grid integer[3][3],
+		mood public.mood,
+		code character(2) collate pg_catalog."C",
+		ttl interval day to second(3),
+		seq integer default public.next_id()
+	);
+	alter table only users
+		add constraint users_pkey primary key (id);
+	alter table users add constraint users_fkey foreign key (id) references accounts(id) deferrable initially deferred not valid,
+		alter column id set default nextval('users_id_seq'::regclass), owner to postgres;
+	alter table users alter column id add generated by default as identity (sequence name users_id_seq start with 1);
+	alter table if exists users alter status drop default, alter column status drop not null, cluster on users_pkey;
+	alter table only users replica identity full;
+	alter table only measurement attach partition measurement_2024 for values from ('2024-01-01') to ('2025-01-01');
+	alter sequence users_id_seq owner to postgres;
+	alter schema app owner to postgres;
+	alter function f() owner to postgres;
+	create function f() returns trigger language plpgsql as $body$
+	begin
+		return 'it''s;';
+	end;
+	$body$;`
+	tr.ValidateOK(ddl)
+
+	ddl = `alter table users add column name text;`
+	tr.ValidateNG(ddl, 1, "add")
+
+	ddl = `alter table users alter column name type varchar(10);`
+	tr.ValidateNG(ddl, 1, "type")
+
+	ddl = `alter table users set schema app;`
+	tr.ValidateNG(ddl, 1, "set")
+
+	ddl = `select pg_catalog.set_config('search_path', '', false);`
+	tr.ValidateNG(ddl, 1, "select")
+
+	ddl = `create table users (
+		status text default 'active'::
+	);`
+	tr.ValidateNG(ddl, 3, ")")
+
+	/* -------------------------------------------------- */
+	ddl = `create unique index users_email_idx on public.users using btree (lower((email)::text));
+	create table users (
+		email text
+	);`
+	tr.ValidateOK(ddl)
+
 	/* -------------------------------------------------- */
 }
\ No newline at end of file
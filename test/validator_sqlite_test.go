@@ -57,7 +57,7 @@ func TestValidate_SQLite(t *testing.T) {
 		aaaa integer,
 		aaaa integer -comment
 	);`
-	tr.ValidateNG(ddl, 3, "-comment")
+	tr.ValidateNG(ddl, 3, "-")
 
 	ddl = `create table users (
 		aaaa integer,
@@ -133,6 +133,15 @@ func TestValidate_SQLite(t *testing.T) {
 	ddl = "create table `scm.users (aaaa integer);"
 	tr.ValidateNG(ddl, 1, "<EOF>")
 
+	ddl = `create table [scm].[user list] (
+		[aaaa] integer references [users] ([aaaa]),
+		[select] text
+	);`
+	tr.ValidateOK(ddl)
+
+	ddl = `create table [scm.users (aaaa integer);`
+	tr.ValidateNG(ddl, 1, "<EOF>")
+
 	/* -------------------------------------------------- */
 	fmt.Println("Column Date Type")
 
@@ -142,14 +151,46 @@ func TestValidate_SQLite(t *testing.T) {
 		aaaa numeric,
 		aaaa integer,
 		aaaa real,
-		aaaa none
+		aaaa none,
+		aaaa int,
+		aaaa bigint,
+		aaaa varchar(255),
+		aaaa decimal(10, 5),
+		aaaa double precision,
+		aaaa blob,
+		aaaa datetime
 	);`
 	tr.ValidateOK(ddl)
 
+	ddl = `create table users (
+		aaaa varchar(a)
+	);`
+	tr.ValidateNG(ddl, 2, "a")
+
 	ddl = `create table users (
 		aaaa integerrr
 	);`
 	tr.ValidateNG(ddl, 2, "integerrr")
+
+	ddl = `create table sqlite_sequence(name,seq);
+	create table config (
+		k primary key,
+		v not null default 0,
+		w constraint w_check check(w > 0),
+		x
+	);`
+	tr.ValidateOK(ddl)
+
+	ddl = `create table users (
+		aaaa, 
+		bbbb aaaa integer
+	);`
+	tr.ValidateNG(ddl, 3, "aaaa")
+
+	ddl = `create table 'users' (
+		aaaa integer
+	)`
+	tr.ValidateNG(ddl, 1, "'users'")
 	
 	/* -------------------------------------------------- */
 	fmt.Println("Table Option")
@@ -167,7 +208,11 @@ func TestValidate_SQLite(t *testing.T) {
 	
 	create table users (
 		aaaa integer
-	) without rowid, strict;`
+	) without rowid, strict;
+
+	create table users (
+		aaaa any
+	) strict;`
 	tr.ValidateOK(ddl)
 
 	ddl = `create table users (
@@ -205,7 +250,7 @@ func TestValidate_SQLite(t *testing.T) {
 		aaaa integer,
 		constraintttt check(aaaa)
 	);`
-	tr.ValidateNG(ddl, 3, "check")
+	tr.ValidateOK(ddl)
 
 	ddl = `create table users (
 		aaaa integer,
@@ -358,6 +403,49 @@ func TestValidate_SQLite(t *testing.T) {
 	);`
 	tr.ValidateNG(ddl, 2, "primary")
 
+	ddl = `create table users (
+		id integer constraint pk_id primary key constraint nn_id not null constraint chk_id check (id > 0),
+		name text constraint df_name default 'a' constraint fk_name references names (name)
+	);`
+	tr.ValidateOK(ddl)
+
+	ddl = `create table users (
+		id integer constraint pk_id primary key constraint nn_id
+	);`
+	tr.ValidateNG(ddl, 3, ")")
+
+	ddl = `create table users (
+		id integer constraint pk_id primary key constraint chk_id constraint chk_id2 check (id > 0)
+	);`
+	tr.ValidateNG(ddl, 2, "constraint")
+
+	/* -------------------------------------------------- */
+	fmt.Println("Temporary Table")
+	ddl = `create temporary table users (
+		aaaa integer
+	);
+	create temp table if not exists users (
+		aaaa integer
+	) without rowid;`
+	tr.ValidateOK(ddl)
+
+	ddl = `create temp table users (
+		aaaa integer
+	) on commit drop;`
+	tr.ValidateNG(ddl, 3, "on")
+
+	/* -------------------------------------------------- */
+	fmt.Println("Create Table As")
+	ddl = `create table archive as select * from users where id > 1;
+	create temp table if not exists archive2 as select id, name from users;`
+	tr.ValidateOK(ddl)
+
+	ddl = `create table archive as insert into users values (1);`
+	tr.ValidateNG(ddl, 1, "insert")
+
+	ddl = `create table archive like users;`
+	tr.ValidateNG(ddl, 1, "like")
+
 	/* -------------------------------------------------- */
 	fmt.Println("Create Other Than Table")
 	ddl = `create table users (
@@ -402,5 +490,47 @@ func TestValidate_SQLite(t *testing.T) {
 	END;`
 	tr.ValidateNG(ddl, 5, "TRIGGE")
 
+	ddl = `create virtual table if not exists main.docs using fts5(title, body, tokenize = 'porter ascii', prefix = '2 3');
+	create virtual table r using rtree(id, minx, maxx);
+	create virtual table m using mod;`
+	tr.ValidateOK(ddl)
+
+	ddl = `create virtual table docs using fts5(title, body;`
+	tr.ValidateNG(ddl, 1, ";")
+
+	ddl = `create virtual table docs fts5(title, body);`
+	tr.ValidateNG(ddl, 1, "fts5")
+
+	/* -------------------------------------------------- */
+	ddl = `drop table if exists main.users;
+	drop index idx_users;
+	drop view if exists v1;
+	drop trigger trg;
+	create table users (
+		aaaa integer
+	);`
+	tr.ValidateOK(ddl)
+
+	ddl = `drop table users, orders;`
+	tr.ValidateNG(ddl, 1, ",")
+
+	ddl = `drop table users cascade;`
+	tr.ValidateNG(ddl, 1, "cascade")
+
+	/* -------------------------------------------------- */
+	ddl = `create table users (
+		id integer,
+		name text,
+		primary key (id desc),
+		unique (name asc, id)
+	);`
+	tr.ValidateOK(ddl)
+
+	ddl = `create table users (
+		id integer,
+		primary key (id desc desc)
+	);`
+	tr.ValidateNG(ddl, 3, "desc")
+
 	/* -------------------------------------------------- */
 }
\ No newline at end of file